@@ -0,0 +1,325 @@
+// terraform-cmd.go - Put this in cmd/terraform/lock/ folder
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/merna"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/output"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/table"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/terraform"
+)
+
+type Flags struct {
+	output    output.Flags
+	tool      string
+	platforms []string
+	parallel  bool
+	upgrade   bool
+	reset     bool
+	yes       bool
+	dryRun    string // "", "annotated" or "script"
+	verbose   int    // Deprecated local -v, for roots without core.AddPersistentFlags
+	// --output was passed: print a lockResult instead of the success message
+	structured bool
+}
+
+// lockResult is what --output prints after a successful lock
+type lockResult struct {
+	Tool      string   `json:"tool" yaml:"tool"`
+	Platforms []string `json:"platforms" yaml:"platforms"`
+	LockFile  string   `json:"lockFile" yaml:"lockFile"`
+	BackedUp  bool     `json:"backedUp" yaml:"backedUp"` // An existing lock file was kept aside until the run succeeded
+	Reset     bool     `json:"reset" yaml:"reset"`
+	Upgraded  bool     `json:"upgraded" yaml:"upgraded"` // The final init needed -upgrade
+}
+
+func Cmd() *cobra.Command {
+	flags := &Flags{}
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Generates a .terraform.lock.hcl for the given platforms",
+		Run: func(cmd *cobra.Command, _ []string) {
+			applyVerbose(flags.verbose)
+			applyConfig(cmd, flags)
+			flags.structured = cmd.Flags().Changed("output")
+			execute(flags)
+		},
+	}
+
+	// Human messages unless --output is passed, for scripts
+	flags.output.Bind(cmd, output.TypeJSON, output.TypeYaml)
+	flags.output.SetDefaultFormat(output.TypeJSON)
+	flags.output.QueryString = "."
+
+	defaults := terraform.DefaultConfig()
+	cmd.Flags().StringVarP(&flags.tool, "tool", "t", defaults.Tool, "The tool to run (tofu or terraform)")
+	cmd.Flags().StringSliceVarP(&flags.platforms, "platform", "p", defaults.Platforms, "The os_arch platforms to lock providers for, or \"all\" for every common platform")
+	cmd.Flags().BoolVar(&flags.parallel, "parallel-platforms", false, "Lock each platform in its own process, in parallel, and report failures per platform")
+	cmd.Flags().BoolVar(&flags.reset, "reset", false, "Remove .terraform and the lock file before locking (asks first)")
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "Don't ask before removing files with --reset")
+	cmd.Flags().BoolVar(&flags.upgrade, "upgrade-on-init-failure", false, "Retry the final init once with -upgrade if it fails because providers changed")
+	cmd.Flags().StringVar(&flags.dryRun, "dry-run", "", "Print the commands instead of running them; --dry-run=script prints only the commands, e.g. > run.sh, and --log-format json prints one JSON object per step")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = dryRunAnnotated
+
+	// -v used to be lock's own flag. Where the root command registers the
+	// global flags with core.AddPersistentFlags this one shadows them, with
+	// the same meaning.
+	cmd.Flags().CountVarP(&flags.verbose, "verbose", "v", "Show tool output while running (-vv for debug output)")
+	cmd.Flags().MarkDeprecated("verbose", "it becomes the global --verbose once the root command calls core.AddPersistentFlags")
+
+	return cmd
+}
+
+// applyVerbose sets the message level for the deprecated local -v
+func applyVerbose(count int) {
+	switch {
+	case count >= 2:
+		core.SetLevel(core.Debug)
+	case count == 1:
+		core.SetLevel(core.Verbose)
+	}
+}
+
+// applyConfig fills in flags that weren't passed from 'terraform configure'
+func applyConfig(cmd *cobra.Command, flags *Flags) {
+	cfg, err := terraform.LoadConfig()
+	core.ExitIfError(err)
+
+	if !cmd.Flags().Changed("tool") {
+		flags.tool = cfg.Tool
+	}
+	if !cmd.Flags().Changed("platform") {
+		flags.platforms = cfg.Platforms
+	}
+	// An explicit TF_PLUGIN_CACHE_DIR in the environment still wins
+	if cfg.PluginCacheDir != "" && os.Getenv("TF_PLUGIN_CACHE_DIR") == "" {
+		os.Setenv("TF_PLUGIN_CACHE_DIR", cfg.PluginCacheDir)
+	}
+}
+
+func execute(flags *Flags) {
+	core.SetStage("lock")
+
+	if flags.dryRun != "" {
+		core.ExitIfError(dryRun(flags))
+		return
+	}
+
+	// Show tool output with the global --verbose flag
+	verbose := core.GetLevel() >= core.Verbose
+
+	// Fail before touching the lock file if the tool is missing.
+	// 127 is what shells use for "command not found".
+	core.ExitIfErrorCode(terraform.CheckToolInstalled(flags.tool), 127)
+
+	// The tool the user picked has to write lock files, not just any installed one
+	supported, err := terraform.IsLockFileSupported(flags.tool)
+	core.ExitIfError(err)
+	if !supported {
+		core.ExitIfError(fmt.Errorf("%s is too old to write lock files, it needs 0.14 or newer (or use --tool tofu)", flags.tool))
+	}
+
+	platforms, err := terraform.ResolvePlatforms(flags.platforms)
+	core.ExitIfError(err)
+	core.DebugMsg("Locking providers for platforms: " + strings.Join(platforms, ", "))
+
+	// Keep the current lock file so a failed run doesn't leave a half-written one behind
+	backup, err := terraform.BackupLockFile()
+	core.ExitIfError(err)
+	if backup != "" {
+		core.OnExit(func() {
+			if err := terraform.RestoreLockFile(backup); err != nil {
+				core.WarnMsg(err.Error())
+			}
+		})
+	}
+
+	// With --summary, show how each step went, also when one fails
+	summary := core.NewSummary()
+	if core.SummaryEnabled() {
+		core.OnExit(func() { table.PrintSummary(summary) })
+	}
+
+	if flags.reset {
+		core.ExitIfError(summary.Run("reset", func() error {
+			return reset(flags.yes)
+		}))
+	} else {
+		summary.Skip("reset")
+	}
+	upgraded := false
+
+	core.ExitIfError(summary.Run("init", func() error {
+		return withSpinner("Initializing...", verbose, func() error {
+			return terraform.RunInitWithTool(flags.tool, verbose)
+		})
+	}))
+	core.ExitIfError(summary.Run("providers lock", func() error {
+		return withSpinner("Locking providers...", verbose, func() error {
+			if flags.parallel {
+				_, err := terraform.GenerateIacLockParallel(flags.tool, platforms)
+				return err
+			}
+			return terraform.GenerateIacLockWithTool(flags.tool, platforms, verbose)
+		})
+	}))
+
+	// Re-run init so the working directory picks up the new lock file
+	err = summary.Run("init (after lock)", func() error {
+		return withSpinner("Initializing...", verbose, func() error {
+			return terraform.RunInitWithTool(flags.tool, verbose)
+		})
+	})
+	if err != nil && flags.upgrade && terraform.IsProviderInitError(err) {
+		core.WarnMsg("Init failed because providers changed, retrying once with -upgrade")
+		core.DebugMsg(err.Error())
+		err = summary.Run("init (upgrade retry)", func() error {
+			return withSpinner("Initializing with -upgrade...", verbose, func() error {
+				return terraform.RunInitWithTool(flags.tool, verbose, "-upgrade")
+			})
+		})
+		if err == nil {
+			upgraded = true
+			core.OkayMsg("Recovered by running init with -upgrade")
+		}
+	}
+	core.ExitIfError(err)
+
+	// Make sure every requested platform made it into the lock file
+	core.ExitIfError(summary.Run("verify", func() error {
+		return terraform.VerifyLockPlatforms(terraform.LockFileName, platforms)
+	}))
+
+	if backup != "" {
+		os.Remove(backup)
+	}
+	if core.SummaryEnabled() {
+		table.PrintSummary(summary)
+	}
+
+	if !flags.structured {
+		core.OkayMsg("Successfully created " + terraform.LockFileName)
+		return
+	}
+	lockFile, err := filepath.Abs(terraform.LockFileName)
+	if err != nil {
+		lockFile = terraform.LockFileName
+	}
+	flags.output.Print(lockResult{
+		Tool:      flags.tool,
+		Platforms: platforms,
+		LockFile:  lockFile,
+		BackedUp:  backup != "",
+		Reset:     flags.reset,
+		Upgraded:  upgraded,
+	})
+}
+
+// --dry-run modes
+const (
+	dryRunAnnotated = "annotated" // Numbered steps, then the commands to copy
+	dryRunScript    = "script"    // Only the commands, one per line
+)
+
+// dryRunStep is one step of --dry-run with the global --log-format json
+type dryRunStep struct {
+	Step    int      `json:"step"`
+	Desc    string   `json:"desc"`
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+}
+
+// dryRun prints the commands lock would run, without running anything.
+// The commands themselves go to stdout unstyled, so they can be copied or
+// redirected into a script. With --log-format json each step is one JSON object.
+func dryRun(flags *Flags) error {
+	if flags.dryRun != dryRunAnnotated && flags.dryRun != dryRunScript {
+		return fmt.Errorf("invalid --dry-run %q, must be %s or %s", flags.dryRun, dryRunAnnotated, dryRunScript)
+	}
+
+	platforms, err := terraform.ResolvePlatforms(flags.platforms)
+	if err != nil {
+		return err
+	}
+	plan := terraform.PlanLock(flags.tool, platforms, flags.reset)
+
+	if core.GetFormat() == core.FormatJSON {
+		for i, step := range plan {
+			if err := core.RecordMsg(dryRunStep{Step: i + 1, Desc: step.Desc, Command: step.String(), Args: step.Args}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if flags.dryRun == dryRunScript {
+		for _, step := range plan {
+			core.PlainMsg(step.String())
+		}
+		return nil
+	}
+
+	core.StdMsg("Dry run, nothing is changed. lock would:")
+	for i, step := range plan {
+		core.StdMsg(fmt.Sprintf("  %d. %s: %s", i+1, step.Desc, step.String()))
+	}
+	core.StdMsg("\nTo run it yourself:")
+	for _, step := range plan {
+		core.PlainMsg(step.String())
+	}
+	return nil
+}
+
+// reset lists what CleanTerraform is about to remove and, unless yes is set,
+// asks before removing it. The lock file is already backed up at this point.
+func reset(yes bool) error {
+	paths, err := terraform.PlanClean()
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		core.VerboseMsg("Nothing to reset")
+		return nil
+	}
+
+	core.WarnMsg("--reset will remove:")
+	for _, path := range paths {
+		core.WarnMsg("  " + path)
+	}
+
+	if !yes {
+		ok, err := merna.PromptConfirm("Remove these files?", false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("reset aborted, nothing was removed")
+		}
+	}
+
+	removed, err := terraform.CleanTerraform()
+	if err != nil {
+		return err
+	}
+	core.VerboseMsg(fmt.Sprintf("Removed %d path(s)", len(removed)))
+	return nil
+}
+
+// withSpinner runs a step behind a spinner. The spinner is skipped in verbose
+// mode since the tool's own output is streamed to the terminal.
+func withSpinner(label string, verbose bool, step func() error) error {
+	if verbose {
+		return step()
+	}
+	stop := core.Spinner(label)
+	defer stop()
+	return step()
+}
@@ -0,0 +1,94 @@
+// terraform-configure-cmd.go - Put this in cmd/terraform/configure/ folder
+package configure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/terraform"
+)
+
+type Flags struct {
+	tool           string
+	platforms      []string
+	pluginCacheDir string
+	show           bool
+	reset          bool
+}
+
+func Cmd() *cobra.Command {
+	flags := &Flags{}
+	cmd := &cobra.Command{
+		Use:   "configure",
+		Short: "Saves default tool, platforms and plugin cache dir for the terraform commands",
+		Run: func(cmd *cobra.Command, _ []string) {
+			execute(cmd, flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.tool, "tool", "t", "", "The default tool to run (tofu or terraform)")
+	cmd.Flags().StringSliceVarP(&flags.platforms, "platform", "p", nil, "The default os_arch platforms to lock providers for")
+	cmd.Flags().StringVar(&flags.pluginCacheDir, "plugin-cache-dir", "", "Directory to cache downloaded providers in (TF_PLUGIN_CACHE_DIR)")
+	cmd.Flags().BoolVar(&flags.show, "show", false, "Print the effective config")
+	cmd.Flags().BoolVar(&flags.reset, "reset", false, "Delete the config file")
+
+	return cmd
+}
+
+func execute(cmd *cobra.Command, flags *Flags) {
+	path, err := terraform.ConfigPath()
+	core.ExitIfError(err)
+
+	if flags.reset {
+		core.ExitIfError(terraform.ResetConfig())
+		core.OkayMsg("Removed " + path)
+		return
+	}
+
+	if flags.show {
+		cfg, err := terraform.LoadConfig()
+		core.ExitIfError(err)
+		printConfig(path, cfg)
+		return
+	}
+
+	// Only change what was passed, keep the rest of the file
+	cfg, err := terraform.ReadConfigFile()
+	core.ExitIfError(err)
+
+	changed := false
+	if cmd.Flags().Changed("tool") {
+		cfg.Tool = flags.tool
+		changed = true
+	}
+	if cmd.Flags().Changed("platform") {
+		platforms, err := terraform.ResolvePlatforms(flags.platforms)
+		core.ExitIfError(err)
+		cfg.Platforms = platforms
+		changed = true
+	}
+	if cmd.Flags().Changed("plugin-cache-dir") {
+		cfg.PluginCacheDir = flags.pluginCacheDir
+		changed = true
+	}
+	if !changed {
+		core.ExitIfError(fmt.Errorf("nothing to configure, pass --tool, --platform or --plugin-cache-dir (or --show/--reset)"))
+	}
+
+	core.ExitIfError(terraform.SaveConfig(cfg))
+	core.OkayMsg("Saved " + path)
+}
+
+func printConfig(path string, cfg terraform.Config) {
+	pluginCacheDir := cfg.PluginCacheDir
+	if pluginCacheDir == "" {
+		pluginCacheDir = "(not set)"
+	}
+	core.StdMsg("Config file:      " + path)
+	core.StdMsg("Tool:             " + cfg.Tool)
+	core.StdMsg("Platforms:        " + strings.Join(cfg.Platforms, ", "))
+	core.StdMsg("Plugin cache dir: " + pluginCacheDir)
+}
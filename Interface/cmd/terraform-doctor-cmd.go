@@ -0,0 +1,81 @@
+// terraform-doctor-cmd.go - Put this in cmd/terraform/doctor/ folder
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	bubbletable "github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/table"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/terraform"
+)
+
+// Statuses in the doctor table
+const (
+	statusOK      = "ok"
+	statusMissing = "missing"
+	statusError   = "error"
+	statusNotSet  = "not set"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "doctor",
+		Aliases: []string{"check"},
+		Short:   "Checks that tofu/terraform can run lock: versions, lock file support and the plugin cache",
+		Run: func(_ *cobra.Command, _ []string) {
+			execute()
+		},
+	}
+}
+
+func execute() {
+	core.SetStage("doctor")
+
+	var rows []bubbletable.Row
+	usable := 0
+	for _, tool := range terraform.Tools {
+		check := terraform.CheckTool(tool)
+		if check.Usable() {
+			usable++
+		}
+		rows = append(rows, toolRow(check))
+	}
+	rows = append(rows, pluginCacheRow(terraform.CheckPluginCache()))
+
+	core.StdMsg(strings.TrimSuffix(table.RenderTablePlain(table.TableConfig{
+		Columns: []bubbletable.Column{{Title: "Check"}, {Title: "Status"}, {Title: "Details"}},
+		Rows:    rows,
+	}), "\n"))
+
+	if usable == 0 {
+		core.ExitIfError(errors.New("neither tofu nor terraform can write lock files, install one of them"))
+	}
+	core.OkayMsg(fmt.Sprintf("%d of %d tools ready for lock", usable, len(terraform.Tools)))
+}
+
+func toolRow(check terraform.ToolCheck) bubbletable.Row {
+	switch {
+	case check.Path == "":
+		return bubbletable.Row{check.Tool, statusMissing, "not found on PATH"}
+	case check.Err != nil:
+		// First line only, the rest is install guidance
+		detail, _, _ := strings.Cut(check.Err.Error(), "\n")
+		return bubbletable.Row{check.Tool, statusError, detail}
+	}
+	return bubbletable.Row{check.Tool, statusOK, fmt.Sprintf("%s at %s, lock files supported", check.Version, check.Path)}
+}
+
+func pluginCacheRow(check terraform.PluginCacheCheck) bubbletable.Row {
+	switch {
+	case check.Err != nil:
+		return bubbletable.Row{"plugin cache", statusError, check.Err.Error()}
+	case check.Dir == "":
+		return bubbletable.Row{"plugin cache", statusNotSet, "providers are downloaded on every init, see 'terraform configure --plugin-cache-dir'"}
+	}
+	return bubbletable.Row{"plugin cache", statusOK, fmt.Sprintf("%s (from %s), writable", check.Dir, check.Source)}
+}
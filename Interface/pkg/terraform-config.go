@@ -0,0 +1,108 @@
+// terraform-config.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the defaults written by 'terraform configure'
+type Config struct {
+	Tool           string   `yaml:"tool,omitempty"`
+	Platforms      []string `yaml:"platforms,omitempty"`
+	PluginCacheDir string   `yaml:"plugin_cache_dir,omitempty"`
+}
+
+// DefaultConfig is used for anything the config file doesn't set
+func DefaultConfig() Config {
+	return Config{
+		Tool:      "tofu",
+		Platforms: []string{"linux_amd64", "darwin_arm64"},
+	}
+}
+
+// ConfigPath returns ~/.config/statefarm/terraform.yaml (or the OS equivalent)
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "statefarm", "terraform.yaml"), nil
+}
+
+// LoadConfig returns the effective config: DefaultConfig overlaid with
+// whatever the config file sets. A missing file is not an error.
+func LoadConfig() (Config, error) {
+	cfg := DefaultConfig()
+
+	file, err := ReadConfigFile()
+	if err != nil {
+		return cfg, err
+	}
+	if file.Tool != "" {
+		cfg.Tool = file.Tool
+	}
+	if len(file.Platforms) > 0 {
+		cfg.Platforms = file.Platforms
+	}
+	if file.PluginCacheDir != "" {
+		cfg.PluginCacheDir = file.PluginCacheDir
+	}
+	return cfg, nil
+}
+
+// ReadConfigFile returns just what the config file sets
+func ReadConfigFile() (Config, error) {
+	var cfg Config
+
+	path, err := ConfigPath()
+	if err != nil {
+		return cfg, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// SaveConfig writes the config file
+func SaveConfig(cfg Config) error {
+	path, err := ConfigPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// ResetConfig deletes the config file, going back to DefaultConfig
+func ResetConfig() error {
+	path, err := ConfigPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove %s: %w", path, err)
+	}
+	return nil
+}
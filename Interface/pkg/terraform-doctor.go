@@ -0,0 +1,90 @@
+// terraform-doctor.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// Tools are the tools the terraform commands can run, in the order they're checked
+var Tools = []string{"tofu", "terraform"}
+
+// ToolCheck is what Doctor found out about one tool
+type ToolCheck struct {
+	Tool      string
+	Path      string // Empty when it isn't on PATH
+	Version   string
+	LockFiles bool  // It can write lock files
+	Err       error // Why it isn't usable, if it isn't
+}
+
+// Usable reports whether lock can run with the tool
+func (c ToolCheck) Usable() bool {
+	return c.Err == nil && c.LockFiles
+}
+
+// CheckTool finds the tool on PATH and asks for its version
+func CheckTool(tool string) ToolCheck {
+	check := ToolCheck{Tool: tool}
+	path, err := exec.LookPath(tool)
+	if err != nil {
+		check.Err = notInstalledError(tool)
+		return check
+	}
+	check.Path = path
+
+	version, err := ToolVersion(tool)
+	if err != nil {
+		check.Err = err
+		return check
+	}
+	check.Version = version
+	check.LockFiles = lockFileSupported(tool, version)
+	if !check.LockFiles {
+		check.Err = fmt.Errorf("%s %s is too old to write lock files, it needs 0.14 or newer", tool, version)
+	}
+	return check
+}
+
+// PluginCacheCheck is what Doctor found out about the provider plugin cache
+type PluginCacheCheck struct {
+	Dir    string // Empty when no cache is configured
+	Source string // "TF_PLUGIN_CACHE_DIR" or "config"
+	Err    error  // Set when the dir is missing or not writable
+}
+
+// CheckPluginCache finds the plugin cache dir (TF_PLUGIN_CACHE_DIR, else the
+// one saved with 'terraform configure') and checks that it can be written to
+func CheckPluginCache() PluginCacheCheck {
+	check := PluginCacheCheck{Dir: os.Getenv("TF_PLUGIN_CACHE_DIR"), Source: "TF_PLUGIN_CACHE_DIR"}
+	if check.Dir == "" {
+		cfg, err := LoadConfig()
+		if err != nil {
+			check.Err = err
+			return check
+		}
+		check.Dir, check.Source = cfg.PluginCacheDir, "config"
+	}
+	if check.Dir == "" {
+		return check
+	}
+
+	info, err := os.Stat(check.Dir)
+	if err != nil {
+		check.Err = fmt.Errorf("%s doesn't exist, the tools don't create it: %w", check.Dir, err)
+		return check
+	}
+	if !info.IsDir() {
+		check.Err = fmt.Errorf("%s is not a directory", check.Dir)
+		return check
+	}
+	f, err := os.CreateTemp(check.Dir, ".doctor-*")
+	if err != nil {
+		check.Err = fmt.Errorf("%s is not writable: %w", check.Dir, err)
+		return check
+	}
+	f.Close()
+	os.Remove(f.Name())
+	return check
+}
@@ -0,0 +1,244 @@
+// terraform-functions.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// LockFileName is the dependency lock file written by 'providers lock'
+const LockFileName = ".terraform.lock.hcl"
+
+// ErrToolNotInstalled is returned when tofu/terraform isn't on PATH
+var ErrToolNotInstalled = errors.New("not installed")
+
+// Where to get each tool, for the ErrToolNotInstalled message
+var installURLs = map[string]string{
+	"tofu":      "https://opentofu.org/docs/intro/install/",
+	"terraform": "https://developer.hashicorp.com/terraform/install",
+}
+
+// CheckToolInstalled returns an error wrapping ErrToolNotInstalled, with
+// install guidance, if the tool isn't on PATH
+func CheckToolInstalled(tool string) error {
+	if _, err := exec.LookPath(tool); err != nil {
+		return notInstalledError(tool)
+	}
+	return nil
+}
+
+func notInstalledError(tool string) error {
+	err := fmt.Errorf("%s is %w (not found on PATH)", tool, ErrToolNotInstalled)
+	if url, ok := installURLs[tool]; ok {
+		return fmt.Errorf("%w\nInstall it from %s or choose another tool with --tool", err, url)
+	}
+	return err
+}
+
+// ToolError is returned when tofu/terraform ran but did not succeed.
+// It keeps the tool's exit code so the command can exit with it.
+type ToolError struct {
+	Tool     string // "tofu" or "terraform"
+	Stage    string // "init", "providers lock", ...
+	ExitCode int    // -1 if the process never started
+	Stderr   string
+	Err      error
+}
+
+func (e *ToolError) Error() string {
+	msg := fmt.Sprintf("%s %s failed with exit code %d", e.Tool, e.Stage, e.ExitCode)
+	if e.Stderr != "" {
+		return msg + "\nDetails: " + e.Stderr
+	}
+	return msg + "\nTip: Run with --verbose flag for more details"
+}
+
+func (e *ToolError) Unwrap() error {
+	return e.Err
+}
+
+// ExitStatus lets core.ExitIfError exit with the tool's own exit code
+func (e *ToolError) ExitStatus() int {
+	return e.ExitCode
+}
+
+// ErrorStage reports the failing step in core's JSON errors
+func (e *ToolError) ErrorStage() string {
+	return e.Stage
+}
+
+// Bits of init's stderr that mean the providers changed under the lock file,
+// which 'init -upgrade' usually sorts out
+var providerInitErrors = []string{
+	"inconsistent dependency lock file",
+	"locked provider",
+	"does not match configured version constraint",
+	"failed to query available provider packages",
+	"no version is selected",
+	"doesn't match any of the checksums",
+	"-upgrade",
+}
+
+// IsProviderInitError reports whether err is a failed init whose stderr looks
+// like a provider/lock file mismatch rather than e.g. a syntax or backend error
+func IsProviderInitError(err error) bool {
+	var toolErr *ToolError
+	if !errors.As(err, &toolErr) || toolErr.Stage != "init" {
+		return false
+	}
+	stderr := strings.ToLower(toolErr.Stderr)
+	for _, s := range providerInitErrors {
+		if strings.Contains(stderr, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// InTerraformDir reports whether the current directory has any .tf or
+// .tf.json files for init to load
+func InTerraformDir() bool {
+	for _, pattern := range []string{"*.tf", "*.tf.json"} {
+		if matches, err := filepath.Glob(pattern); err == nil && len(matches) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RunInitWithTool runs '<tool> init' in the current directory
+func RunInitWithTool(tool string, verbose bool, extraArgs ...string) error {
+	if !InTerraformDir() {
+		return errors.New("current directory contains no terraform files")
+	}
+
+	core.WarnMsg(fmt.Sprintf("Running %s init...", tool))
+
+	args := append([]string{"init"}, extraArgs...)
+	if err := runTool(tool, "init", "", args, verbose); err != nil {
+		return err
+	}
+
+	core.OkayMsg(fmt.Sprintf("%s init complete.", tool))
+	return nil
+}
+
+// GenerateIacLockWithTool runs '<tool> providers lock' for every requested platform
+func GenerateIacLockWithTool(tool string, platforms []string, verbose bool) error {
+	args := lockArgs(platforms)
+
+	core.WarnMsg(fmt.Sprintf("Generating lock file with %s...", tool))
+
+	if err := runTool(tool, "providers lock", "", args, verbose); err != nil {
+		return err
+	}
+
+	core.OkayMsg("Lock file generated.")
+	return nil
+}
+
+// BackupLockFile copies the current lock file aside and returns the backup path.
+// Returns "" if there is no lock file to back up.
+func BackupLockFile() (string, error) {
+	data, err := os.ReadFile(LockFileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", LockFileName, err)
+	}
+
+	backup := LockFileName + ".bak"
+	if err := os.WriteFile(backup, data, 0o644); err != nil {
+		return "", fmt.Errorf("failed to back up %s: %w", LockFileName, err)
+	}
+	return backup, nil
+}
+
+// RestoreLockFile puts a backup made by BackupLockFile back in place
+func RestoreLockFile(backup string) error {
+	if err := os.Rename(backup, LockFileName); err != nil {
+		return fmt.Errorf("failed to restore %s from %s: %w", LockFileName, backup, err)
+	}
+	return nil
+}
+
+// cleanGlobs match what CleanTerraform removes: the provider/module cache
+// and the lock file. The lock file backup (.bak) is left alone.
+var cleanGlobs = []string{".terraform", LockFileName}
+
+// PlanClean returns the paths CleanTerraform would remove, without removing them
+func PlanClean() ([]string, error) {
+	var paths []string
+	for _, pattern := range cleanGlobs {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("bad clean pattern %q: %w", pattern, err)
+		}
+		paths = append(paths, matches...)
+	}
+	return paths, nil
+}
+
+// CleanTerraform removes everything PlanClean lists and returns what it removed
+func CleanTerraform() ([]string, error) {
+	paths, err := PlanClean()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if err := os.RemoveAll(path); err != nil {
+			return nil, fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+	}
+	return paths, nil
+}
+
+// runTool executes the tool in dir ("" for the current directory) and converts
+// a failure into a *ToolError. Stderr is always captured so the error carries
+// the details, even when not verbose.
+func runTool(tool, stage, dir string, args []string, verbose bool) error {
+	var stderrBuf bytes.Buffer
+
+	cmd := exec.Command(tool, args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderrBuf
+
+	if verbose {
+		// In verbose mode, also show output in real-time
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = io.MultiWriter(os.Stderr, &stderrBuf)
+		core.StdMsg("Running command: " + cmd.String())
+	}
+
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return notInstalledError(tool)
+	}
+
+	toolErr := &ToolError{
+		Tool:     tool,
+		Stage:    stage,
+		ExitCode: -1,
+		Stderr:   strings.TrimSpace(stderrBuf.String()),
+		Err:      err,
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		toolErr.ExitCode = exitErr.ExitCode()
+	}
+
+	return toolErr
+}
@@ -0,0 +1,167 @@
+// terraform-lockfile.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// lockProvider is one provider block of a dependency lock file
+type lockProvider struct {
+	Source      string
+	Version     string
+	Constraints string
+	Hashes      []string
+}
+
+// readLockFile parses the provider blocks of a lock file. Only the subset of
+// HCL that tofu/terraform write is understood.
+func readLockFile(path string) ([]lockProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var providers []lockProvider
+	var current *lockProvider
+	inHashes := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#"):
+		case current == nil && strings.HasPrefix(text, "provider "):
+			source := strings.TrimSuffix(strings.TrimPrefix(text, "provider "), "{")
+			current = &lockProvider{Source: unquote(source)}
+		case current == nil:
+			return nil, fmt.Errorf("%s:%d: expected a provider block", path, line)
+		case inHashes && text == "]":
+			inHashes = false
+		case inHashes:
+			current.Hashes = append(current.Hashes, unquote(strings.TrimSuffix(text, ",")))
+		case text == "}":
+			providers = append(providers, *current)
+			current = nil
+		case strings.HasPrefix(text, "hashes"):
+			inHashes = !strings.HasSuffix(text, "]")
+		default:
+			key, value, ok := strings.Cut(text, "=")
+			if !ok {
+				return nil, fmt.Errorf("%s:%d: unexpected %q", path, line, text)
+			}
+			switch strings.TrimSpace(key) {
+			case "version":
+				current.Version = unquote(value)
+			case "constraints":
+				current.Constraints = unquote(value)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("%s: unterminated provider block %q", path, current.Source)
+	}
+	return providers, nil
+}
+
+// writeLockFile writes the providers in the same layout tofu/terraform use
+func writeLockFile(path string, providers []lockProvider) error {
+	var b strings.Builder
+	b.WriteString("# This file is maintained automatically by \"tofu init\".\n")
+	b.WriteString("# Manual edits may be lost in future updates.\n")
+	for _, p := range providers {
+		fmt.Fprintf(&b, "\nprovider %q {\n", p.Source)
+		fmt.Fprintf(&b, "  version     = %q\n", p.Version)
+		if p.Constraints != "" {
+			fmt.Fprintf(&b, "  constraints = %q\n", p.Constraints)
+		}
+		b.WriteString("  hashes = [\n")
+		for _, h := range p.Hashes {
+			fmt.Fprintf(&b, "    %q,\n", h)
+		}
+		b.WriteString("  ]\n}\n")
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+// mergeLockProviders combines the hashes recorded for each provider across
+// several lock files. The first file's version and constraints win.
+func mergeLockProviders(files ...[]lockProvider) []lockProvider {
+	bySource := make(map[string]*lockProvider)
+	hashes := make(map[string]map[string]bool)
+	var order []string
+
+	for _, providers := range files {
+		for _, p := range providers {
+			merged, ok := bySource[p.Source]
+			if !ok {
+				merged = &lockProvider{Source: p.Source, Version: p.Version, Constraints: p.Constraints}
+				bySource[p.Source] = merged
+				hashes[p.Source] = make(map[string]bool)
+				order = append(order, p.Source)
+			}
+			for _, h := range p.Hashes {
+				if !hashes[p.Source][h] {
+					hashes[p.Source][h] = true
+					merged.Hashes = append(merged.Hashes, h)
+				}
+			}
+		}
+	}
+
+	sort.Strings(order)
+	result := make([]lockProvider, len(order))
+	for i, source := range order {
+		sort.Strings(bySource[source].Hashes)
+		result[i] = *bySource[source]
+	}
+	return result
+}
+
+// VerifyLockPlatforms checks the lock file at path has hashes for every platform.
+//
+// Lock files don't record which platform a hash belongs to, so this counts:
+// 'providers lock' writes one h1: hash per platform it packaged, and the zh:
+// hashes from the registry. A provider with fewer h1: hashes than platforms,
+// or no hashes at all, is reported as a gap.
+func VerifyLockPlatforms(path string, platforms []string) error {
+	providers, err := readLockFile(path)
+	if err != nil {
+		return err
+	}
+
+	var gaps []string
+	for _, p := range providers {
+		h1, zh := 0, 0
+		for _, h := range p.Hashes {
+			switch {
+			case strings.HasPrefix(h, "h1:"):
+				h1++
+			case strings.HasPrefix(h, "zh:"):
+				zh++
+			}
+		}
+		switch {
+		case h1 == 0 && zh == 0:
+			gaps = append(gaps, fmt.Sprintf("%s: no hashes", p.Source))
+		case h1 < len(platforms):
+			gaps = append(gaps, fmt.Sprintf("%s: h1: hashes for %d of %d platforms", p.Source, h1, len(platforms)))
+		}
+	}
+
+	if len(gaps) > 0 {
+		return fmt.Errorf("%s does not cover %s:\n  %s", path, strings.Join(platforms, ", "), strings.Join(gaps, "\n  "))
+	}
+	return nil
+}
+
+func unquote(s string) string {
+	return strings.Trim(strings.TrimSpace(s), `"`)
+}
@@ -0,0 +1,171 @@
+// terraform-parallel.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// MaxParallelLocks bounds how many 'providers lock' processes run at once
+const MaxParallelLocks = 4
+
+// PlatformResult is the outcome of locking a single platform
+type PlatformResult struct {
+	Platform string
+	Err      error
+}
+
+// GenerateIacLockParallel runs '<tool> providers lock' once per platform, up to
+// MaxParallelLocks at a time, then merges the hashes of the platforms that
+// succeeded into LockFileName. The error lists every platform that failed.
+//
+// Each run gets its own workspace next to the current directory, holding
+// symlinks to everything in it, so relative module paths still resolve and
+// the runs never write the same lock file.
+func GenerateIacLockParallel(tool string, platforms []string) ([]PlatformResult, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	core.WarnMsg(fmt.Sprintf("Generating lock file with %s for %d platforms in parallel...", tool, len(platforms)))
+
+	results := make([]PlatformResult, len(platforms))
+	locks := make([][]lockProvider, len(platforms))
+	sem := make(chan struct{}, MaxParallelLocks)
+	var wg sync.WaitGroup
+
+	for i, platform := range platforms {
+		wg.Add(1)
+		go func(i int, platform string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i] = PlatformResult{Platform: platform}
+			locks[i], results[i].Err = lockPlatform(tool, cwd, platform)
+		}(i, platform)
+	}
+	wg.Wait()
+
+	icons := core.CurrentIcons()
+	var failed []string
+	var firstErr error
+	var succeeded [][]lockProvider
+	for i, r := range results {
+		if r.Err != nil {
+			core.WarnMsg(fmt.Sprintf("%s %s: %s", icons.Error, r.Platform, r.Err))
+			failed = append(failed, r.Platform)
+			if firstErr == nil {
+				firstErr = r.Err
+			}
+			continue
+		}
+		core.VerboseMsg(icons.Success + " " + r.Platform)
+		succeeded = append(succeeded, locks[i])
+	}
+
+	if len(succeeded) > 0 {
+		if err := writeLockFile(LockFileName, mergeLockProviders(succeeded...)); err != nil {
+			return results, fmt.Errorf("failed to write %s: %w", LockFileName, err)
+		}
+	}
+
+	if len(failed) > 0 {
+		// Unwraps to the first failure, so the tool's exit code and stage survive
+		return results, &summaryError{
+			msg: fmt.Sprintf("providers lock failed for %d of %d platforms: %s",
+				len(failed), len(platforms), strings.Join(failed, ", ")),
+			err: firstErr,
+		}
+	}
+
+	core.OkayMsg("Lock file generated.")
+	return results, nil
+}
+
+// lockPlatform runs 'providers lock' for one platform in a workspace of its own
+// and returns the providers it locked
+func lockPlatform(tool, dir, platform string) ([]lockProvider, error) {
+	workspace, err := os.MkdirTemp(filepath.Dir(dir), "."+filepath.Base(dir)+"-lock-"+platform+"-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+	defer os.RemoveAll(workspace)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		src := filepath.Join(dir, entry.Name())
+		dst := filepath.Join(workspace, entry.Name())
+		// The lock file is copied, not linked, so the run can't write through to ours
+		if entry.Name() == LockFileName {
+			data, err := os.ReadFile(src)
+			if err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(dst, data, 0o644); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := os.Symlink(src, dst); err != nil {
+			return nil, fmt.Errorf("failed to set up workspace: %w", err)
+		}
+	}
+
+	// Output would interleave across runs, so it only ends up in the error
+	args := []string{"providers", "lock", "-platform=" + platform}
+	if err := runTool(tool, "providers lock", workspace, args, false); err != nil {
+		var toolErr *ToolError
+		if errors.As(err, &toolErr) && toolErr.Stderr != "" {
+			return nil, &summaryError{msg: diagnosticLine(toolErr.Stderr), err: err}
+		}
+		return nil, err
+	}
+
+	return readLockFile(filepath.Join(workspace, LockFileName))
+}
+
+// summaryError is a one-line message for the per-platform summary that
+// still unwraps to the error behind it, e.g. the platform's ToolError
+type summaryError struct {
+	msg string
+	err error
+}
+
+func (e *summaryError) Error() string {
+	return e.msg
+}
+
+func (e *summaryError) Unwrap() error {
+	return e.err
+}
+
+// diagnosticLine keeps per-platform errors to a single line in the summary:
+// the first "Error:" line of the tool's diagnostics, without the box drawn
+// around them, else the first line with any text
+func diagnosticLine(stderr string) string {
+	first := ""
+	for _, line := range strings.Split(stderr, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "╷│╵"))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "Error:") {
+			return line
+		}
+		if first == "" {
+			first = line
+		}
+	}
+	return first
+}
@@ -0,0 +1,76 @@
+package terraform
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// Diagnostics the way tofu/terraform print them on stderr
+const providerDiagnostics = `╷
+│ Error: Failed to query available provider packages
+│
+│ Could not retrieve the list of available versions for provider hashicorp/aws
+╵`
+
+func TestDiagnosticLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   string
+	}{
+		{"box", providerDiagnostics, "Error: Failed to query available provider packages"},
+		{"warning before the error", "╷\n│ Warning: Deprecated\n╵\n╷\n│ Error: Invalid platform\n╵", "Error: Invalid platform"},
+		{"no Error line", "something went wrong\nmore detail", "something went wrong"},
+		{"only the box", "╷\n│\n╵", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diagnosticLine(tt.stderr); got != tt.want {
+				t.Errorf("diagnosticLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeTool puts an executable named tool on PATH that prints stderr and
+// exits with code
+func fakeTool(t *testing.T, tool, stderr string, code int) {
+	t.Helper()
+	dir := t.TempDir()
+	script := "#!/bin/sh\ncat >&2 <<'EOS'\n" + stderr + "\nEOS\nexit " + strconv.Itoa(code) + "\n"
+	if err := os.WriteFile(filepath.Join(dir, tool), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestGenerateIacLockParallelKeepsToolError(t *testing.T) {
+	fakeTool(t, "faketofu", providerDiagnostics, 3)
+	t.Chdir(t.TempDir())
+	core.SetOutput(io.Discard, io.Discard)
+	t.Cleanup(func() { core.SetOutput(nil, nil) })
+
+	results, err := GenerateIacLockParallel("faketofu", []string{"linux_amd64", "darwin_arm64"})
+	if err == nil {
+		t.Fatal("GenerateIacLockParallel() succeeded with a failing tool")
+	}
+	if !strings.Contains(err.Error(), "2 of 2 platforms: linux_amd64, darwin_arm64") {
+		t.Errorf("error = %q, want the failed platforms", err)
+	}
+	var toolErr *ToolError
+	if !errors.As(err, &toolErr) || toolErr.ExitStatus() != 3 || toolErr.ErrorStage() != "providers lock" {
+		t.Errorf("error %v doesn't carry the tool's exit code 3 and stage", err)
+	}
+	for _, r := range results {
+		if r.Err == nil || r.Err.Error() != "Error: Failed to query available provider packages" {
+			t.Errorf("%s: error = %v, want the diagnostic's Error line", r.Platform, r.Err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// terraform-plan.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+// PlannedCommand is a step 'lock' would run, for --dry-run
+type PlannedCommand struct {
+	Desc string   // What the step is for, e.g. "Lock providers"
+	Args []string // The command line, tool first
+}
+
+// String is the command as a shell line, quoting arguments that need it
+func (c PlannedCommand) String() string {
+	quoted := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// PlanLock returns the commands 'lock' runs, in order: the optional reset,
+// init, providers lock for the platforms and the init that picks up the new
+// lock file. The parallel mode locks each platform in a scratch copy and
+// merges the results, which comes down to the same single lock command.
+func PlanLock(tool string, platforms []string, reset bool) []PlannedCommand {
+	var plan []PlannedCommand
+	if reset {
+		plan = append(plan, PlannedCommand{
+			Desc: "Remove the provider cache and the lock file",
+			Args: append([]string{"rm", "-rf"}, cleanGlobs...),
+		})
+	}
+	return append(plan,
+		PlannedCommand{Desc: "Initialize", Args: []string{tool, "init"}},
+		PlannedCommand{Desc: "Lock providers", Args: append([]string{tool}, lockArgs(platforms)...)},
+		PlannedCommand{Desc: "Initialize with the new lock file", Args: []string{tool, "init"}},
+	)
+}
+
+// lockArgs are the 'providers lock' arguments for the platforms
+func lockArgs(platforms []string) []string {
+	args := []string{"providers", "lock"}
+	for _, platform := range platforms {
+		args = append(args, "-platform="+platform)
+	}
+	return args
+}
+
+// safeShellArg matches arguments a POSIX shell takes as is
+var safeShellArg = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// shellQuote single-quotes s unless the shell would take it as is
+func shellQuote(s string) string {
+	if safeShellArg.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,84 @@
+// terraform-platforms.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"fmt"
+	"strings"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// PlatformAll expands to AllSupportedPlatforms in ResolvePlatforms
+const PlatformAll = "all"
+
+// Platforms most providers publish builds for on the Terraform registry
+var commonPlatforms = []string{
+	"linux_amd64",
+	"linux_arm64",
+	"darwin_amd64",
+	"darwin_arm64",
+	"windows_amd64",
+}
+
+// Valid, but few providers publish for these, so locking them often fails
+var uncommonPlatforms = []string{
+	"linux_386",
+	"linux_arm",
+	"windows_386",
+	"windows_arm64",
+	"freebsd_386",
+	"freebsd_amd64",
+	"freebsd_arm",
+	"openbsd_386",
+	"openbsd_amd64",
+	"solaris_amd64",
+}
+
+// AllSupportedPlatforms returns the common registry os_arch combinations
+func AllSupportedPlatforms() []string {
+	return append([]string(nil), commonPlatforms...)
+}
+
+// ResolvePlatforms expands "all", drops duplicates and checks every platform
+// is a known os_arch. Uncommon platforms only print a warning.
+func ResolvePlatforms(platforms []string) ([]string, error) {
+	var resolved []string
+	seen := make(map[string]bool)
+	add := func(p string) {
+		if !seen[p] {
+			seen[p] = true
+			resolved = append(resolved, p)
+		}
+	}
+
+	for _, p := range platforms {
+		p = strings.ToLower(strings.TrimSpace(p))
+		switch {
+		case p == PlatformAll:
+			for _, c := range commonPlatforms {
+				add(c)
+			}
+		case contains(commonPlatforms, p):
+			add(p)
+		case contains(uncommonPlatforms, p):
+			core.WarnMsg(fmt.Sprintf("Platform %s is uncommon, some providers may not publish builds for it", p))
+			add(p)
+		default:
+			return nil, fmt.Errorf("unknown platform %q, expected os_arch (e.g. linux_amd64) or %q", p, PlatformAll)
+		}
+	}
+
+	if len(resolved) == 0 {
+		return nil, fmt.Errorf("no platforms given")
+	}
+	return resolved, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// terraform-version.go - Put this in pkg/terraform/ folder
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// minLockTerraformVersion is the first terraform release with dependency lock files
+const minLockTerraformVersion = "0.14.0"
+
+// versionPattern finds the version in 'terraform version' output, e.g. "Terraform v1.5.7"
+var versionPattern = regexp.MustCompile(`v?(\d+\.\d+(?:\.\d+)?)`)
+
+// ToolVersion returns the version of tofu/terraform, e.g. "1.6.2". It asks
+// for -json first and falls back to parsing the first line of the plain
+// output on releases that don't support it.
+func ToolVersion(tool string) (string, error) {
+	out, err := exec.Command(tool, "version", "-json").Output()
+	if err == nil {
+		var v struct {
+			Version string `json:"terraform_version"`
+		}
+		if json.Unmarshal(out, &v) == nil && v.Version != "" {
+			return v.Version, nil
+		}
+	}
+
+	out, err = exec.Command(tool, "version").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run %s version: %w", tool, err)
+	}
+	firstLine, _, _ := strings.Cut(string(out), "\n")
+	match := versionPattern.FindStringSubmatch(firstLine)
+	if match == nil {
+		return "", fmt.Errorf("couldn't find a version in %q", strings.TrimSpace(firstLine))
+	}
+	return match[1], nil
+}
+
+// IsLockFileSupported reports whether the given tool can write lock files:
+// every tofu release can, terraform needs 0.14 or newer
+func IsLockFileSupported(tool string) (bool, error) {
+	if tool == "tofu" {
+		return true, nil
+	}
+	version, err := ToolVersion(tool)
+	if err != nil {
+		return false, err
+	}
+	return lockFileSupported(tool, version), nil
+}
+
+// lockFileSupported is IsLockFileSupported for an already known version
+func lockFileSupported(tool, version string) bool {
+	return tool == "tofu" || compareVersions(version, minLockTerraformVersion) >= 0
+}
+
+// compareVersions compares dotted versions part by part as numbers, so
+// 0.9 < 0.14 (which a string comparison gets wrong). Pre-release and build
+// suffixes (-beta1, +ent) are ignored, missing parts count as 0. Returns
+// -1, 0 or 1.
+func compareVersions(a, b string) int {
+	pa, pb := versionParts(a), versionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	var parts []int
+	for _, s := range strings.Split(v, ".") {
+		n, _ := strconv.Atoi(s)
+		parts = append(parts, n)
+	}
+	return parts
+}
@@ -1,19 +1,37 @@
 package appservices
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/mattn/go-runewidth"
 	"github.com/spf13/cobra"
-	
-	tableui "/pkg/table" // Import the table package
+	"golang.org/x/term"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/merna"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/output"
+	tableui "sfgitlab.opr.statefarm.org/sf/statefarm/pkg/table" // Import the table package
 )
 
 type Flags struct {
 	output.Flags
+	format string // The --output value, table (or unset, the default) and wide are printed by output.PrintTable
 	id     string
 	tui    bool // Add TUI flag
+	cache  merna.CacheOptions
+	keepGoing bool
+	lazy   bool
+	noTUIFallback bool
+	countOnly bool
+	labelColumns []string
+	watch  time.Duration
 }
 
 func Cmd() *cobra.Command {
@@ -21,12 +39,15 @@ func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "app-services",
 		Short: "Gets all app services given a business app",
-		Run: func(_ *cobra.Command, _ []string) {
+		Run: func(cmd *cobra.Command, _ []string) {
+			if f := cmd.Flags().Lookup("output"); f != nil {
+				flags.format = f.Value.String()
+			}
 			execute(flags)
 		},
 	}
 
-	flags.output.Bind(cmd, output.TypeJSON, output.TypeYaml, output.TypeTable)
+	flags.output.Bind(cmd, output.TypeJSON, output.TypeYaml, output.TypeTable, output.TypeTableWide)
 	flags.output.SetDefaultFormat(output.TypeTable)
 	// default query output to "." for jq
 	flags.output.QueryString = "."
@@ -34,6 +55,15 @@ func Cmd() *cobra.Command {
 	// Add the TUI flag
 	cmd.Flags().BoolVar(&flags.tui, "tui", false, "Display results in interactive table UI")
 	cmd.Flags().StringVarP(&flags.id, "id", "i", "", "The SOLID ID of the business application")
+	cmd.Flags().BoolVar(&flags.cache.NoCache, "no-cache", false, "Don't read or write the local app services cache")
+	cmd.Flags().BoolVar(&flags.cache.Refresh, "refresh", false, "Ignore the local cache and fetch fresh app services")
+	cmd.Flags().BoolVar(&flags.keepGoing, "keep-going", false, "Retry failed pages and print what was fetched instead of exiting on a page error")
+	cmd.Flags().BoolVar(&flags.noTUIFallback, "no-tui-fallback", false, "With --tui, fail instead of printing a plain table when there's no terminal")
+	cmd.Flags().BoolVar(&flags.lazy, "lazy", false, "With --tui, fetch pages as you page forward instead of all up front (skips the cache)")
+	cmd.Flags().BoolVar(&flags.countOnly, "count-only", false, "Only print the number of app services")
+	cmd.Flags().DurationVar(&flags.cache.TTL, "cache-ttl", merna.DefaultCacheTTL, "How long cached app services are used")
+	cmd.Flags().StringSliceVar(&flags.labelColumns, "label-columns", nil, "With --tui, add a column for each of these labels, e.g. team,cost-center")
+	cmd.Flags().DurationVar(&flags.watch, "watch", 0, "Fetch the app services again on this interval (e.g. 10s) and refresh the output, until Ctrl+C")
 
 	return cmd
 }
@@ -42,49 +72,287 @@ func execute(flags *Flags) {
 	id, err := merna.PromptSolmaID(flags.id)
 	core.ExitIfError(err)
 
+	// app-services isn't scoped to an environment, so the cache is keyed by id alone
+	getServices := func(showProgress bool) ([]merna.ApplicationServices, error) {
+		return merna.GetAppServicesCached(id, "", flags.cache, func() ([]merna.ApplicationServices, error) {
+			return fetchAppServices(id, showProgress, flags.keepGoing)
+		})
+	}
+
+	if flags.countOnly {
+		count, err := countAppServices(id, getServices)
+		core.ExitIfError(err)
+		core.StdMsg(fmt.Sprintf("%d", count))
+		return
+	}
+
+	tableui.SetTUIFallback(!flags.noTUIFallback)
+
+	// Every refresh has to see new data, the cache is still written
+	if flags.watch > 0 {
+		flags.cache.Refresh = true
+	}
+
+	// Large business apps: only fetch the pages that are looked at
+	if flags.tui && flags.lazy {
+		if flags.watch > 0 {
+			core.WarnMsg("--watch is ignored with --lazy, press r in the table to refresh")
+		}
+		core.ExitIfError(tableui.ShowTable(lazyAppServicesTableConfig(id, flags.labelColumns)))
+		return
+	}
+
+	// If TUI flag is set, start the table UI right away and fetch behind a loading spinner
+	if flags.tui {
+		displayTableUI(func() ([]merna.ApplicationServices, error) {
+			return getServices(false)
+		}, flags.labelColumns, flags.watch)
+		return
+	}
+
+	if flags.watch > 0 {
+		watchAppServices(flags, func() ([]merna.ApplicationServices, error) {
+			return getServices(false)
+		})
+		return
+	}
+
+	// Otherwise, use the existing output format
+	applicationServices, err := getServices(true)
+	if errors.Is(err, merna.ErrPartialResults) {
+		core.WarnMsg(err.Error())
+	} else {
+		core.ExitIfError(err)
+	}
+	printAppServices(flags, applicationServices)
+}
+
+// printAppServices prints the services in the --output format
+func printAppServices(flags *Flags, services []merna.ApplicationServices) {
+	core.StdMsg(fmt.Sprintf("\nTotal technical services: %d", len(services)))
+	switch flags.format {
+	case "", output.TypeTable, output.TypeTableWide:
+		// Our own table printer, it colors the status cells
+		core.ExitIfError(output.PrintTable(os.Stdout, services, flags.format == output.TypeTableWide))
+	default:
+		flags.output.Print(services)
+	}
+}
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// watchAppServices prints the app services, then fetches and reprints them
+// every flags.watch until Ctrl+C. The wait starts once a fetch is done, so
+// fetches never overlap. The screen is cleared between prints when stdout is
+// a terminal; piped output gets one print after another. A failed fetch
+// is shown and retried on the next tick.
+func watchAppServices(flags *Flags, fetch func() ([]merna.ApplicationServices, error)) {
+	stop := make(chan struct{})
+	_, release := core.CatchInterrupt(func() {
+		close(stop)
+		// Stops once the fetch in flight is done, a second Ctrl+C quits right away
+		signal.Reset(os.Interrupt, syscall.SIGTERM)
+	})
+	defer release()
+
+	tty := term.IsTerminal(int(os.Stdout.Fd()))
+	for {
+		services, err := fetch()
+		if tty {
+			fmt.Print(clearScreen)
+		}
+		switch {
+		case errors.Is(err, merna.ErrPartialResults):
+			core.WarnMsg(err.Error())
+			printAppServices(flags, services)
+		case err != nil:
+			core.ErrorMsg(err.Error())
+		default:
+			printAppServices(flags, services)
+		}
+		core.StdMsg(fmt.Sprintf("Updated %s, every %s (Ctrl+C to stop)", time.Now().Format("15:04:05"), flags.watch))
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(flags.watch):
+		}
+	}
+}
+
+// pageAttempts is how often --keep-going tries a page before giving up
+const pageAttempts = 3
+
+// fetchAppServices collects every page of app services for the business app.
+// showProgress is off for the TUI, which shows its own loading spinner.
+// With keepGoing a failed page is retried, and if it keeps failing the pages
+// fetched so far are returned with an error wrapping merna.ErrPartialResults.
+// The same goes for Ctrl+C outside the TUI: it stops after the current page.
+func fetchAppServices(id string, showProgress, keepGoing bool) ([]merna.ApplicationServices, error) {
 	var applicationServices []merna.ApplicationServices
 	var cursor *string
 	hasNext := true
 
+	// The TUI gets Ctrl+C as a key and cancels itself
+	interrupted := func() bool { return false }
+	if showProgress {
+		var release func()
+		interrupted, release = core.CatchInterrupt(func() {
+			core.WarnMsg("Interrupted, stopping after this page (Ctrl+C again to quit)")
+			// A second Ctrl+C kills the process as usual
+			signal.Reset(os.Interrupt, syscall.SIGTERM)
+		})
+		defer release()
+	}
+
+	// Created once the first page tells us the total (spinner if the API doesn't report one)
+	var bar *core.Progress
+
+	// Failed page requests, and attempts at the current page
+	page, failed, attempts := 1, 0, 0
+
 	// Collect all app services (pagination logic remains the same)
 	for hasNext {
 		resp, err := merna.GetAppServices(id, cursor)
-		core.ExitIfError(err)
 
-		// Check for errors using the common error handling function from merna
-		errMessages := merna.HandleErrors(resp.Errors)
-		if len(errMessages) > 0 {
-			core.ErrorMsg(strings.Join(errMessages, "\n"))
-			return
+		// Check for errors using the common error handling function from merna.
+		// Stop on fatal errors, warnings are printed and the page is still used.
+		if err == nil {
+			apiErrors := merna.HandleErrors(resp.Errors)
+			if merna.HasFatal(apiErrors) {
+				err = errors.New(strings.Join(merna.HandleErrorStrings(resp.Errors), "\n"))
+			} else {
+				for _, apiErr := range apiErrors {
+					core.WarnMsg(apiErr.Error())
+				}
+			}
+		}
+
+		if err != nil {
+			if !keepGoing {
+				bar.Done()
+				return nil, err
+			}
+			failed++
+			attempts++
+			core.WarnMsg(fmt.Sprintf("Page %d failed (attempt %d of %d): %s", page, attempts, pageAttempts, err))
+			if attempts < pageAttempts {
+				continue
+			}
+			// The next cursor comes from this page, so there's no getting past it
+			bar.Done()
+			return applicationServices, fmt.Errorf("%w: %d page requests failed, stopped after %d services",
+				merna.ErrPartialResults, failed, len(applicationServices))
 		}
+		attempts = 0
+		page++
 
-		applicationServices = append(applicationServices, resp.Data.PaginatedApplicationServices...)
+		if bar == nil {
+			if showProgress {
+				bar = core.ProgressBar(resp.Data.PaginatedApplicationServices.TotalCount)
+			} else {
+				bar = &core.Progress{}
+			}
+		}
+		bar.Add(len(resp.Data.PaginatedApplicationServices.Results))
+
+		applicationServices = append(applicationServices, resp.Data.PaginatedApplicationServices.Results...)
 
 		// Check if there are more app services
 		hasNext = resp.Data.PaginatedApplicationServices.HasNext
 		if hasNext {
 			cursor = &resp.Data.PaginatedApplicationServices.Cursor
 		}
+
+		if hasNext && interrupted() {
+			bar.Done()
+			return applicationServices, fmt.Errorf("%w: interrupted after %d pages, showing the first %d services",
+				merna.ErrPartialResults, page-1, len(applicationServices))
+		}
 	}
+	bar.Done()
 
-	// If TUI flag is set, display in table UI
-	if flags.tui {
-		displayTableUI(applicationServices)
-		return
+	if failed > 0 {
+		core.WarnMsg(fmt.Sprintf("%d page requests failed and were retried", failed))
 	}
+	return applicationServices, nil
+}
 
-	// Otherwise, use the existing output format
-	core.StdMsg(fmt.Sprintf("\nTotal technical services: %d", len(applicationServices)))
-	flags.output.Print(applicationServices)
+// countAppServices asks the API for the total, which the first page
+// reports, and only counts the services itself (through getServices, so
+// from the cache when it's fresh) when there is no total
+func countAppServices(id string, getServices func(showProgress bool) ([]merna.ApplicationServices, error)) (int, error) {
+	resp, err := merna.GetAppServices(id, nil)
+	if err == nil && merna.HasFatal(merna.HandleErrors(resp.Errors)) {
+		err = errors.New(strings.Join(merna.HandleErrorStrings(resp.Errors), "\n"))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	page := resp.Data.PaginatedApplicationServices
+	if page.TotalCount > 0 {
+		return page.TotalCount, nil
+	}
+	if !page.HasNext {
+		return len(page.Results), nil
+	}
+
+	services, err := getServices(true)
+	if errors.Is(err, merna.ErrPartialResults) {
+		core.WarnMsg(err.Error())
+		err = nil
+	}
+	return len(services), err
 }
 
-// displayTableUI shows the app services in an interactive table
-func displayTableUI(services []merna.ApplicationServices) {
-	if len(services) == 0 {
+// errNoAppServices ends the loading screen when there is nothing to show
+var errNoAppServices = errors.New("no application services found")
+
+// displayTableUI shows the app services in an interactive table. The table UI
+// starts immediately and shows a spinner while fetch runs. With watch set
+// the table's auto refresh calls fetch again on that interval; it skips a
+// tick while a refresh is still running.
+func displayTableUI(fetch func() ([]merna.ApplicationServices, error), labels []string, watch time.Duration) {
+	err := tableui.ShowTableLoading("Loading application services...", func() (tableui.TableConfig, error) {
+		services, err := fetch()
+		partial := errors.Is(err, merna.ErrPartialResults)
+		if err != nil && !partial {
+			return tableui.TableConfig{}, err
+		}
+		if len(services) == 0 {
+			return tableui.TableConfig{}, errNoAppServices
+		}
+		config, err := appServicesTableConfig(services, labels)
+		if partial {
+			config.Title += " - incomplete, some pages failed"
+		}
+		if watch > 0 {
+			config.RefreshFunc = func() ([]table.Row, error) {
+				services, err := fetch()
+				if err != nil && !errors.Is(err, merna.ErrPartialResults) {
+					return nil, err
+				}
+				return appServicesRows(services, config.Columns, labels)
+			}
+			config.AutoRefresh = watch
+		}
+		return config, err
+	})
+	if errors.Is(err, errNoAppServices) {
 		fmt.Println("No application services found")
 		return
 	}
+	core.ExitIfError(err)
+}
+
+// Minimum width of a --label-columns column
+const labelColumnWidth = 12
 
+// appServicesColumns are the columns of the app services table, then one
+// per label from --label-columns
+func appServicesColumns(labels []string) []table.Column {
 	// Define table columns with appropriate widths
 	columns := []table.Column{
 		{Title: "Name", Width: 30},
@@ -94,23 +362,48 @@ func displayTableUI(services []merna.ApplicationServices) {
 		{Title: "Environment", Width: 12},
 		{Title: "Created By", Width: 15},
 	}
+	for _, label := range labels {
+		width := runewidth.StringWidth(label) + 2
+		if width < labelColumnWidth {
+			width = labelColumnWidth
+		}
+		columns = append(columns, table.Column{Title: label, Width: width})
+	}
+	return columns
+}
 
-	// Convert services to table rows
-	rows := make([]table.Row, 0, len(services))
-	for _, svc := range services {
-		row := table.Row{
-			truncateString(svc.Name, 30),
-			truncateString(svc.Type, 15),
-			truncateString(svc.Capability, 15),
-			truncateString(svc.Status, 12),
-			truncateString(svc.Environment, 12),
-			truncateString(svc.CreatedBy, 15),
+// appServicesRows converts services to table rows, truncated to the column
+// widths. Label columns come from the services' Labels map (the API's labels,
+// map[string]string), empty for a service without that label.
+func appServicesRows(services []merna.ApplicationServices, columns []table.Column, labels []string) ([]table.Row, error) {
+	fields := []string{"Name", "Type", "Capability", "Status", "Environment", "CreatedBy"}
+	for _, label := range labels {
+		fields = append(fields, "Labels."+label)
+	}
+	rows, err := tableui.StructsToRows(services, fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = truncateString(row[i], columns[i].Width)
 		}
-		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// appServicesTableConfig builds the table for the app services
+func appServicesTableConfig(services []merna.ApplicationServices, labels []string) (tableui.TableConfig, error) {
+	columns := appServicesColumns(labels)
+
+	// Convert services to table rows
+	rows, err := appServicesRows(services, columns, labels)
+	if err != nil {
+		return tableui.TableConfig{}, err
 	}
 
-	// Create and show the table with pagination
-	config := tableui.TableConfig{
+	// Create the table with pagination
+	return tableui.TableConfig{
 		Title:          fmt.Sprintf("Application Services (Total: %d)", len(services)),
 		Columns:        columns,
 		Rows:           rows,
@@ -118,20 +411,56 @@ func displayTableUI(services []merna.ApplicationServices) {
 		Height:         25,
 		RowsPerPage:    10,  // Show 10 rows per page
 		ShowPagination: true,
+	}, nil
+}
+
+// lazyAppServicesTableConfig is the app services table fetching one API page
+// at a time, as the user pages forward
+func lazyAppServicesTableConfig(id string, labels []string) tableui.TableConfig {
+	columns := appServicesColumns(labels)
+
+	// Pages are requested in order, so the next cursor is always the last one seen
+	var cursor *string
+	fetchPage := func(int) ([]table.Row, bool, error) {
+		resp, err := merna.GetAppServices(id, cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		if merna.HasFatal(merna.HandleErrors(resp.Errors)) {
+			return nil, false, errors.New(strings.Join(merna.HandleErrorStrings(resp.Errors), "\n"))
+		}
+
+		page := resp.Data.PaginatedApplicationServices
+		rows, err := appServicesRows(page.Results, columns, labels)
+		if err != nil {
+			return nil, false, err
+		}
+		if page.HasNext {
+			next := page.Cursor
+			cursor = &next
+		}
+		return rows, page.HasNext, nil
 	}
 
-	if err := tableui.ShowTable(config); err != nil {
-		core.ExitIfError(err)
+	return tableui.TableConfig{
+		Title:          "Application Services",
+		Columns:        columns,
+		Height:         25,
+		RowsPerPage:    10,
+		ShowPagination: true,
+		FetchPage:      fetchPage,
 	}
 }
 
-// Helper function to truncate long strings for table display
+// Helper function to truncate long strings for table display.
+// Measures display width rather than bytes so multibyte names (accents, CJK)
+// are never cut in the middle of a character.
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if runewidth.StringWidth(s) <= maxLen {
 		return s
 	}
 	if maxLen <= 3 {
-		return s[:maxLen]
+		return runewidth.Truncate(s, maxLen, "")
 	}
-	return s[:maxLen-3] + "..."
+	return runewidth.Truncate(s, maxLen, "...")
 }
\ No newline at end of file
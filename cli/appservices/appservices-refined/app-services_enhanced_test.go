@@ -0,0 +1,46 @@
+package appservices
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		maxLen int
+		want   string
+	}{
+		{"fits", "orders-api", 12, "orders-api"},
+		{"ascii", "orders-api-primary", 10, "orders-..."},
+		{"accented", "café-crème-service", 10, "café-cr..."},
+		{"combining accent", "café-creme-service", 8, "café-..."},
+		{"cjk", "注文サービス本番", 9, "注文サ..."},
+		{"cjk odd width", "注文サービス", 8, "注文..."},
+		{"max 3", "orders", 3, "ord"},
+		{"max 3 cjk", "注文サービス", 3, "注"},
+		{"max 1 cjk", "注文", 1, ""},
+		{"max 0", "orders", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateString(tt.s, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("truncateString(%q, %d) = %q, want %q", tt.s, tt.maxLen, got, tt.want)
+			}
+			if w := runewidth.StringWidth(got); w > tt.maxLen {
+				t.Errorf("truncateString(%q, %d) is %d wide", tt.s, tt.maxLen, w)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncateString(%q, %d) = %q splits a rune", tt.s, tt.maxLen, got)
+			}
+			if kept := strings.TrimSuffix(got, "..."); !strings.HasPrefix(tt.s, kept) {
+				t.Errorf("truncateString(%q, %d) = %q isn't a prefix of the input", tt.s, tt.maxLen, got)
+			}
+		})
+	}
+}
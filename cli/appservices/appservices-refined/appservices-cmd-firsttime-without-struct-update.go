@@ -2,9 +2,9 @@ package appservices
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/mattn/go-runewidth"
 	"github.com/spf13/cobra"
 	"sfgitlab.opr.statefarm.org/sf/statefarm/cmd/merna/create"
 	"sfgitlab.opr.statefarm.org/sf/statefarm/cmd/merna/del"
@@ -58,9 +58,10 @@ func execute(flags *Flags) {
 		core.ExitIfError(err)
 
 		// Check for errors using the common error handling function from merna
-		errMessages := merna.HandleErrors(resp.Errors)
-		if len(errMessages) > 0 {
-			core.ErrorMsg(strings.Join(errMessages, "\n"))
+		// In TUI mode each error can be expanded for its code and details
+		apiErrors := merna.HandleErrors(resp.Errors)
+		if len(apiErrors) > 0 {
+			core.ExitIfError(merna.ShowErrors(apiErrors, flags.tui))
 			return
 		}
 
@@ -129,13 +130,15 @@ func displayTableUI(services []merna.ApplicationServices) {
 	}
 }
 
-// Helper function to truncate long strings for table display
+// Helper function to truncate long strings for table display.
+// Measures display width rather than bytes so multibyte names (accents, CJK)
+// are never cut in the middle of a character.
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if runewidth.StringWidth(s) <= maxLen {
 		return s
 	}
 	if maxLen <= 3 {
-		return s[:maxLen]
+		return runewidth.Truncate(s, maxLen, "")
 	}
-	return s[:maxLen-3] + "..."
+	return runewidth.Truncate(s, maxLen, "...")
 }
\ No newline at end of file
@@ -0,0 +1,102 @@
+package table
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// Aggregates for TableConfig.Aggregates
+const (
+	AggregateSum   = "sum"
+	AggregateAvg   = "avg"
+	AggregateCount = "count" // Non-empty cells, numeric or not
+	AggregateMax   = "max"
+	AggregateMin   = "min"
+)
+
+// Footer line with the aggregates, under the rows
+var footerStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("229")).
+	Bold(true)
+
+// computeAggregates works out each aggregate over rows. Cells that don't
+// parse as numbers (thousands separators are fine) are left out of sum,
+// avg, max and min; a column without any numbers gets no value.
+func computeAggregates(rows []table.Row, aggregates map[int]string) map[int]string {
+	if len(aggregates) == 0 {
+		return nil
+	}
+
+	values := make(map[int]string, len(aggregates))
+	for col, kind := range aggregates {
+		count, numbers := 0, 0
+		var sum, highest, lowest float64
+		for _, row := range rows {
+			if col >= len(row) {
+				continue
+			}
+			cell := strings.TrimSpace(row[col])
+			if cell != "" {
+				count++
+			}
+			n, err := strconv.ParseFloat(strings.ReplaceAll(cell, ",", ""), 64)
+			if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+				continue
+			}
+			if numbers == 0 || n > highest {
+				highest = n
+			}
+			if numbers == 0 || n < lowest {
+				lowest = n
+			}
+			sum += n
+			numbers++
+		}
+
+		switch {
+		case kind == AggregateCount:
+			values[col] = "count " + strconv.Itoa(count)
+		case numbers == 0:
+			// No numbers to aggregate, leave the cell empty
+		case kind == AggregateSum:
+			values[col] = "sum " + formatAggregate(sum)
+		case kind == AggregateAvg:
+			values[col] = "avg " + formatAggregate(sum/float64(numbers))
+		case kind == AggregateMax:
+			values[col] = "max " + formatAggregate(highest)
+		case kind == AggregateMin:
+			values[col] = "min " + formatAggregate(lowest)
+		}
+	}
+	return values
+}
+
+// formatAggregate shows whole numbers without decimals, others with two
+func formatAggregate(n float64) string {
+	if n == math.Trunc(n) && math.Abs(n) < 1e15 {
+		return strconv.FormatFloat(n, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(n, 'f', 2, 64)
+}
+
+// renderFooter lines the aggregates up under their columns
+func (m TableModel) renderFooter(positions []int, columns []table.Column) string {
+	pad := m.cellPadding() / 2
+
+	var b strings.Builder
+	for _, col := range m.leadingColumns() {
+		b.WriteString(strings.Repeat(" ", col.Width+m.cellPadding()))
+	}
+	for i, pos := range positions {
+		value := m.footer[m.colOrder[pos]]
+		width := columns[i].Width
+		cell := runewidth.FillRight(runewidth.Truncate(value, width, "…"), width)
+		b.WriteString(strings.Repeat(" ", pad) + cell + strings.Repeat(" ", pad))
+	}
+	return footerStyle.Render(strings.TrimRight(b.String(), " "))
+}
@@ -4,11 +4,44 @@ package table
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// Detail panel box
+var infoBox = core.BoxOptions{
+	Color:     core.BoxAccentColor,
+	PaddingY:  1,
+	PaddingX:  1,
+	MarginTop: 1,
+}
+
+var (
+	// Status message style
+	flashStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("42")).
+		MarginTop(1)
+	
+	// Background of every other row with Zebra
+	zebraStyle = lipgloss.NewStyle().
+		Background(lipgloss.Color("236"))
+	
+	// Inline refresh error style
+	refreshErrorStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("196")).
+		MarginTop(1)
 )
 
 // TableModel wraps the bubbles table with additional functionality
@@ -18,10 +51,123 @@ type TableModel struct {
 	width         int
 	height        int
 	allRows       []table.Row  // Store all rows for pagination
-	currentPage   int
-	rowsPerPage   int
-	totalRows     int
+	pager         core.Paginator // Current page, rows per page and total rows
 	showPagination bool
+
+	// Horizontal scrolling for tables wider than the terminal
+	allColumns    []table.Column
+	colOffset     int  // First visible column
+	visibleCols   int  // Number of columns that fit in the width
+	
+	// Fit the columns to the width by Priority instead of scrolling (see fitColumns)
+	fitToWidth    bool
+	droppedCols   int  // Columns left out to fit, for the "+N more" hint
+	
+	// Wrapped cells span several visual rows in the bubbles table
+	columnOptions map[int]ColumnOptions
+	visualToRow   []int // Visual row -> row index on the current page
+	rowStarts     []int // Row index on the current page -> its first visual row
+	
+	// Drawn by renderTable from the plain rows in the bubbles table
+	styles        table.Styles
+	paint         []linePaint // Visual row -> how its cells are styled
+	offset        int // First visual row on screen
+	
+	// Leading status indicator, computed per row so allRows stays clean
+	statusFunc    func(table.Row) (string, lipgloss.Style)
+	
+	// Display-only value transforms, applied per cell so allRows stays raw
+	formatters    map[int]func(string) string
+	
+	// Sections by a column's value (GroupBy), collapsed with enter on the header
+	groupBy       int // Column index, -1 when not grouping
+	collapsed     map[string]bool
+	entries       []groupEntry // Headers and the rows of expanded groups, what the pager pages through
+	
+	// Footer aggregates by column index, recomputed with the view
+	aggregates    map[int]string
+	footer        map[int]string // Computed values, e.g. "sum 42"
+	footerLine    string
+	
+	// Display order of the columns, as indexes into allColumns and each row.
+	// Reordered with '<'/'>' and kept for the session; allRows is never touched.
+	// Hidden columns are left out.
+	colOrder      []int
+	
+	// Focused column (a display position), used for copying a single cell
+	focusedCol    int
+	
+	// Short status message shown under the table until it expires or the
+	// next key press, set with setFlash
+	flash         string
+	flashID       int
+	
+	// Refetching rows on demand
+	refreshFunc   func() ([]table.Row, error)
+	refreshing    bool
+	refreshErr    error
+	
+	// Fetching pages on demand (FetchPage), appended to allRows as they arrive
+	fetchPage     func(page int) ([]table.Row, bool, error)
+	fetchedPages  int // pager.Open while FetchPage has more, reset by setRows
+	loadingMore   bool
+	fetchErr      error
+	
+	// Refetching rows on an interval
+	autoRefresh   time.Duration
+	paused        bool
+	lastUpdated   time.Time
+	
+	// Mouse wheel scrolling, only turned on if the terminal supports it
+	mouse         bool
+	
+	// Key bindings, also used to render the help
+	keys          KeyMap
+	
+	// Dense rendering, toggled at runtime. pager.PageSize grows while dense.
+	dense         bool
+	baseRowsPerPage int
+	
+	// Alternating row background
+	zebra         bool
+	
+	// Search with '/', n/N jump between the rows that match
+	searching     bool // Typing the term
+	searchInput   textinput.Model
+	searchTerm    string
+	
+	// Full screen key binding help, toggled with '?'
+	showHelp      bool
+	
+	// Detail panel for the selected row
+	detailFunc    func(table.Row) string
+	showDetails   bool
+	scrollingDetails bool // No pager available, ↑/↓ scroll the panel instead of the rows
+	detailOffset  int
+	
+	// Row selection (ShowTableSelect)
+	selectMode    bool
+	actions       []string
+	showActions   bool
+	actionCursor  int
+	chosenRow     table.Row
+	chosenIndex   int // Index into allRows of chosenRow, -1 until picked
+	chosenAction  string
+	
+	// Marking several rows (ShowTableMultiSelect)
+	multiSelect   bool
+	marked        map[int]bool // Keyed by index into allRows, setRows moves them with their rows
+	chosenRows    []table.Row
+	confirmQuit   bool
+	confirmingQuit bool // Showing "Discard N selections? (y/n)"
+}
+
+// ColumnOptions holds optional per-column behaviour
+type ColumnOptions struct {
+	Wrap     bool // Wrap long values onto multiple lines instead of truncating
+	Hidden   bool // Never shown (table, details, search, exports), but kept in the rows returned by the pickers, e.g. an ID
+	Priority int  // Setting this on any column fits the table to the width instead of scrolling: lower priority columns shrink, then drop, first
+	MinWidth int  // With Priority: narrowest the column shrinks to, defaults to minFitWidth
 }
 
 // TableConfig holds configuration for creating a new table
@@ -33,6 +179,43 @@ type TableConfig struct {
 	Height         int  // Optional: defaults to 20
 	RowsPerPage    int  // Optional: defaults to 10 (0 means no pagination)
 	ShowPagination bool // Optional: defaults to true if RowsPerPage > 0
+	RowActions     []string // Optional: actions offered after picking a row in ShowTableSelect
+	DetailFunc     func(table.Row) string // Optional: multi-line details shown for the selected row
+	ColumnOptions  map[int]ColumnOptions // Optional: per-column options keyed by column index
+	RefreshFunc    func() ([]table.Row, error) // Optional: refetches all rows when 'r' is pressed
+	AutoRefresh    time.Duration // Optional: also call RefreshFunc on this interval
+	KeyMap         KeyMap // Optional: override individual key bindings, unset ones keep the defaults
+	Dense          bool // Optional: no cell padding, header underline or title margin, fitting more rows per screen
+	StatusFunc     func(table.Row) (glyph string, style lipgloss.Style) // Optional: styled indicator (●/○/✗) shown in a leading column
+	ConfirmQuit    bool // Optional: in ShowTableMultiSelect, ask before quitting with rows marked
+	Zebra          bool // Optional: subtle background on every other row (off under NO_COLOR)
+	PlainTabs      bool // Optional: RenderTablePlain (and the no-terminal fallback) writes tab-separated values instead of a bordered table
+	Mouse          bool // Optional: scroll rows with the mouse wheel, where the terminal supports it
+	FetchPage      func(page int) (rows []table.Row, more bool, err error) // Optional: load pages lazily as the user pages forward, called with 0, 1, 2, ... in order. Rows holds any already fetched.
+	Aggregates     map[int]string // Optional: footer aggregate per column index, AggregateSum/Avg/Count/Max/Min over all loaded rows (non-numeric cells ignored)
+	Formatters     map[int]func(string) string // Optional: per-column display transforms keyed by column index, e.g. a Unix timestamp to a date. Only what's drawn (and searched) is formatted: copies, Markdown export and the rows returned by the pickers keep the raw values.
+	GroupRows      bool // Optional: show the rows in sections, one per value of the GroupBy column. Each value gets a bold header with its row count; enter on a header collapses/expands it and pages count the shown lines.
+	GroupBy        int // Optional: with GroupRows, the column index to group the rows by
+}
+
+// Widths of the leading StatusFunc and mark columns
+const (
+	statusColumnWidth = 2
+	markColumnWidth   = 2
+)
+
+// denseSavedLines is how many more rows fit on screen in dense mode:
+// the title margin, the header underline and the gap above the pagination
+const denseSavedLines = 3
+
+// autoRefreshTickMsg fires every second while AutoRefresh is set, both to
+// redraw the "last updated" indicator and to check if a refresh is due
+type autoRefreshTickMsg time.Time
+
+// refreshMsg carries the result of RefreshFunc back to Update
+type refreshMsg struct {
+	rows []table.Row
+	err  error
 }
 
 // New creates a new table model with the given configuration
@@ -47,6 +230,11 @@ func New(config TableConfig) TableModel {
 		config.Height = 20
 	}
 	
+	// Lazy loading always pages, there's no "all rows" to show at once
+	if config.FetchPage != nil && config.RowsPerPage == 0 {
+		config.RowsPerPage = 10
+	}
+	
 	// Setup pagination
 	showPagination := config.RowsPerPage > 0
 	if config.RowsPerPage == 0 {
@@ -59,8 +247,11 @@ func New(config TableConfig) TableModel {
 		tableHeight -= 2
 	}
 	
+	// Zero and negative column widths would garble the rendering
+	config.Columns = checkColumnWidths(config.Columns)
+	
 	// Get initial page of rows
-	displayRows := getPageRows(config.Rows, 0, config.RowsPerPage)
+	displayRows := core.PageItems(core.NewPaginator(config.RowsPerPage, len(config.Rows)), config.Rows)
 
 	// Create the table
 	t := table.New(
@@ -71,6 +262,60 @@ func New(config TableConfig) TableModel {
 	)
 
 	// Apply clean, simple styles
+	t.SetStyles(tableStyles(config.Dense))
+
+	// Auto refresh needs something to refresh with
+	if config.RefreshFunc == nil {
+		config.AutoRefresh = 0
+	}
+	
+	m := TableModel{
+		table:          t,
+		title:          config.Title,
+		width:          config.Width,
+		height:         config.Height,
+		allRows:        config.Rows,
+		pager:          core.Paginator{PageSize: config.RowsPerPage, Total: len(config.Rows), Open: config.FetchPage != nil},
+		showPagination: showPagination,
+		allColumns:     config.Columns,
+		colOrder:       visibleOrder(config.Columns, config.ColumnOptions),
+		fitToWidth:     hasPriorities(config.ColumnOptions),
+		columnOptions:  config.ColumnOptions,
+		refreshFunc:    config.RefreshFunc,
+		autoRefresh:    config.AutoRefresh,
+		lastUpdated:    time.Now(),
+		actions:        config.RowActions,
+		detailFunc:     config.DetailFunc,
+		keys:           config.KeyMap.withDefaults(),
+		dense:          config.Dense,
+		baseRowsPerPage: config.RowsPerPage,
+		statusFunc:     config.StatusFunc,
+		formatters:     config.Formatters,
+		aggregates:     config.Aggregates,
+		confirmQuit:    config.ConfirmQuit,
+		marked:         map[int]bool{},
+		mouse:          config.Mouse,
+		zebra:          config.Zebra && os.Getenv("NO_COLOR") == "",
+		searchInput:    newSearchInput(),
+		fetchPage:      config.FetchPage,
+		groupBy:        -1,
+	}
+	if config.GroupRows {
+		m.groupBy = config.GroupBy
+	}
+	m.regroup()
+	
+	// Init starts fetching the first page
+	m.loadingMore = m.needsPage()
+	
+	// Size the table and only show the columns that fit in the width
+	m.applyLayout()
+	
+	return m
+}
+
+// tableStyles builds the bubbles table styles, with or without padding
+func tableStyles(dense bool) table.Styles {
 	s := table.DefaultStyles()
 	
 	// Header style - just bold with underline
@@ -90,24 +335,56 @@ func New(config TableConfig) TableModel {
 		Background(lipgloss.Color("57")).
 		Bold(false)
 	
-	t.SetStyles(s)
+	
+	// Dense drops the cell padding and the header underline
+	if dense {
+		s.Header = s.Header.Padding(0).BorderBottom(false)
+		s.Cell = s.Cell.Padding(0)
+	}
+	
+	return s
+}
 
-	return TableModel{
-		table:          t,
-		title:          config.Title,
-		width:          config.Width,
-		height:         config.Height,
-		allRows:        config.Rows,
-		currentPage:    0,
-		rowsPerPage:    config.RowsPerPage,
-		totalRows:      len(config.Rows),
-		showPagination: showPagination,
+// applyLayout sizes the table for the current height and density
+func (m *TableModel) applyLayout() {
+	// Leave room for title, borders, help text, and pagination
+	tableHeight := m.height - 6
+	if m.showPagination {
+		tableHeight -= 2
+	}
+	if len(m.aggregates) > 0 {
+		tableHeight--
 	}
+	
+	m.styles = tableStyles(m.dense)
+	m.table.SetStyles(m.styles)
+	
+	if m.showPagination {
+		// Keep the first row of the current page on screen when the page size changes
+		first := m.pager.Page * m.pager.PageSize
+		m.pager.PageSize = m.baseRowsPerPage
+		if m.dense {
+			m.pager.PageSize += denseSavedLines
+		}
+		m.pager.Page = first / m.pager.PageSize
+	}
+	if m.dense {
+		tableHeight += denseSavedLines
+	}
+	m.table.SetHeight(tableHeight)
+	m.refreshView()
 }
 
 // Init implements tea.Model
 func (m TableModel) Init() tea.Cmd {
-	return nil
+	var cmds []tea.Cmd
+	if m.autoRefresh > 0 {
+		cmds = append(cmds, autoRefreshTick())
+	}
+	if m.loadingMore {
+		cmds = append(cmds, m.fetchPageCmd(m.fetchedPages))
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update implements tea.Model with pagination support
@@ -116,39 +393,273 @@ func (m TableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "ctrl+c", "esc":
+		m.flash = ""
+		
+		// Waiting for y/n on "Discard N selections?" - anything but y goes back
+		if m.confirmingQuit {
+			m.confirmingQuit = false
+			if msg.String() == "y" || msg.String() == "Y" {
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		
+		// The search input takes all keys while it's open
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		
+		// The help overlay takes all keys while it's open
+		if m.showHelp {
+			switch {
+			case key.Matches(msg, m.keys.Help), msg.String() == "esc":
+				m.showHelp = false
+			case key.Matches(msg, m.keys.Quit):
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		
+		// The action menu takes all keys while it's open
+		if m.showActions {
+			return m.updateActions(msg)
+		}
+		
+		// Scrolling the detail panel takes the arrow keys
+		if m.scrollingDetails && m.showDetails && m.updateDetailScroll(msg) {
+			return m, nil
+		}
+		
+		// With a search term set, esc clears it rather than quitting
+		if m.searchTerm != "" && msg.String() == "esc" {
+			m.clearSearch()
+			return m, nil
+		}
+		
+		switch {
+		case key.Matches(msg, m.keys.Quit):
+			// ctrl+c always quits straight away
+			if m.confirmQuit && len(m.marked) > 0 && msg.String() != "ctrl+c" {
+				m.confirmingQuit = true
+				return m, nil
+			}
 			return m, tea.Quit
-		case "left", "h", "pgup":
+		case key.Matches(msg, m.keys.Select) && m.onGroupHeader():
+			m.toggleGroup()
+			return m, nil
+		case m.multiSelect && key.Matches(msg, m.keys.Mark):
+			if index := m.selectedIndex(); index >= 0 {
+				if m.marked[index] {
+					delete(m.marked, index)
+				} else {
+					m.marked[index] = true
+				}
+				m.refreshView()
+			}
+			return m, nil
+		case m.multiSelect && key.Matches(msg, m.keys.Select):
+			m.chosenRows = m.markedRows()
+			return m, tea.Quit
+		case key.Matches(msg, m.keys.Help):
+			m.showHelp = true
+			return m, nil
+		case key.Matches(msg, m.keys.NextColumn):
+			// Focus the next column
+			if m.focusedCol < len(m.colOrder)-1 {
+				m.focusedCol++
+				m.scrollToFocusedColumn()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.PrevColumn):
+			// Focus the previous column
+			if m.focusedCol > 0 {
+				m.focusedCol--
+				m.scrollToFocusedColumn()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.Refresh):
+			// Refetch rows in the background
+			if m.refreshFunc != nil && !m.refreshing {
+				m.refreshing = true
+				m.refreshErr = nil
+				return m, m.refresh()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.Pause):
+			// Pause/resume auto refresh
+			if m.autoRefresh > 0 {
+				m.paused = !m.paused
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.CopyRow):
+			// Copy the whole row, tab-separated
+			if row := m.selectedRow(); row != nil {
+				return m, m.copyToClipboard(strings.Join(m.displayRow(row), "\t"), "row")
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.CopyCell):
+			// Copy just the focused cell
+			if row := m.selectedRow(); row != nil && m.focusedCol < len(m.colOrder) {
+				if col := m.colOrder[m.focusedCol]; col < len(row) {
+					return m, m.copyToClipboard(row[col], m.allColumns[col].Title)
+				}
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.MoveColumnLeft):
+			m.moveFocusedColumn(-1)
+			return m, nil
+		case key.Matches(msg, m.keys.MoveColumnRight):
+			m.moveFocusedColumn(1)
+			return m, nil
+		case key.Matches(msg, m.keys.ToggleDense):
+			m.dense = !m.dense
+			m.applyLayout()
+			return m, nil
+		case key.Matches(msg, m.keys.CopyMarkdown):
+			return m, m.copyMarkdown()
+		case key.Matches(msg, m.keys.OpenPager):
+			return m, m.openPager()
+		case key.Matches(msg, m.keys.Search):
+			return m, m.startSearch()
+		case m.searchTerm != "" && key.Matches(msg, m.keys.NextMatch):
+			return m, m.jumpToMatch(m.searchFrom()+1, 1)
+		case m.searchTerm != "" && key.Matches(msg, m.keys.PrevMatch):
+			return m, m.jumpToMatch(m.searchFrom()-1, -1)
+		case key.Matches(msg, m.keys.ToggleDetails):
+			// Space always toggles details, enter is reserved for picking a row in select mode
+			if m.detailFunc != nil {
+				m.showDetails = !m.showDetails
+				return m, nil
+			}
+		case key.Matches(msg, m.keys.Select):
+			if !m.selectMode && m.detailFunc != nil {
+				m.showDetails = !m.showDetails
+				return m, nil
+			}
+			if m.selectMode {
+				row := m.selectedRow()
+				if row == nil {
+					return m, nil
+				}
+				// No actions configured - behave like a plain single-select
+				if len(m.actions) == 0 {
+					m.chosenRow = row
+					m.chosenIndex = m.selectedIndex()
+					return m, tea.Quit
+				}
+				m.showActions = true
+				m.actionCursor = 0
+				return m, nil
+			}
+		case key.Matches(msg, m.keys.ScrollLeft):
+			// Scroll columns left
+			if m.colOffset > 0 {
+				m.colOffset--
+				m.refreshView()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.ScrollRight):
+			// Scroll columns right
+			if m.hasColumnsRight() {
+				m.colOffset++
+				m.refreshView()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.PrevPage):
 			// Previous page
-			if m.showPagination && m.currentPage > 0 {
-				m.currentPage--
+			if m.showPagination && m.pager.Prev() {
 				m.updateTableRows()
 			}
-		case "right", "l", "pgdown":
-			// Next page
-			if m.showPagination && m.hasNextPage() {
-				m.currentPage++
+		case key.Matches(msg, m.keys.NextPage):
+			// Retry a page that failed to load before moving on
+			if m.fetchErr != nil && m.needsPage() {
+				return m, m.fetchNextPage()
+			}
+			// Next page, fetching it first if it isn't loaded yet
+			if m.showPagination && m.pager.Next() {
 				m.updateTableRows()
+				if m.needsPage() {
+					return m, m.fetchNextPage()
+				}
 			}
 		}
 		
+	case autoRefreshTickMsg:
+		// Skip while paused or while a refresh is still running so requests never stack up
+		due := time.Since(m.lastUpdated) >= m.autoRefresh
+		if due && !m.paused && !m.refreshing {
+			m.refreshing = true
+			m.refreshErr = nil
+			return m, tea.Batch(m.refresh(), autoRefreshTick())
+		}
+		return m, autoRefreshTick()
+		
+	case tea.MouseMsg:
+		switch msg.Type {
+		case tea.MouseWheelUp:
+			m.table.MoveUp(1)
+		case tea.MouseWheelDown:
+			m.table.MoveDown(1)
+		}
+		m.followCursor()
+		return m, nil
+		
+	case pagerDoneMsg:
+		if msg.err != nil {
+			return m, m.setFlash(core.CurrentIcons().Warning + " Pager failed: " + msg.err.Error())
+		}
+		return m, nil
+		
+	case flashExpiredMsg:
+		m.flashExpired(msg)
+		return m, nil
+		
+	case pageLoadedMsg:
+		m, cmd = m.pageLoaded(msg)
+		return m, cmd
+		
+	case refreshMsg:
+		m.refreshing = false
+		if msg.err != nil {
+			m.refreshErr = msg.err
+			// Wait a full interval before trying again
+			m.lastUpdated = time.Now()
+			return m, nil
+		}
+		m.setRows(msg.rows)
+		m.lastUpdated = time.Now()
+		return m, m.setFlash(fmt.Sprintf("%s Refreshed (%d rows)", core.CurrentIcons().Success, len(msg.rows)))
+		
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		tableHeight := msg.Height - 6
-		if m.showPagination {
-			tableHeight -= 2
-		}
-		m.table.SetHeight(tableHeight)
+		m.applyLayout()
+	}
+	
+	// Keep the search input's cursor blinking
+	if m.searching {
+		m.searchInput, cmd = m.searchInput.Update(msg)
+		return m, cmd
 	}
 	
+	before := m.table.Cursor()
 	m.table, cmd = m.table.Update(msg)
+	m.skipContinuationRows(before)
+	m.followCursor()
+	if m.zebra && m.table.Cursor() != before {
+		// Restripe so the row the cursor left gets its background back
+		m.refreshView()
+	}
 	return m, cmd
 }
 
 // View implements tea.Model with clean table display
 func (m TableModel) View() string {
+	// The help overlay replaces the whole view
+	if m.showHelp {
+		return m.renderHelp()
+	}
+	
 	// Build the complete view
 	var s strings.Builder
 	
@@ -158,50 +669,111 @@ func (m TableModel) View() string {
 			Bold(true).
 			Foreground(lipgloss.Color("229")).
 			MarginBottom(1)
+		if m.dense {
+			titleStyle = titleStyle.MarginBottom(0)
+		}
 		
 		s.WriteString(titleStyle.Render(m.title))
 		s.WriteString("\n")
 	}
 	
-	// Table with simple border
-	borderStyle := lipgloss.NewStyle().
-		BorderStyle(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		Padding(0, 1)
-	
 	// Get table content
-	tableContent := m.table.View()
+	tableContent := m.renderTable()
+	if m.footerLine != "" {
+		tableContent += "\n" + m.footerLine
+	}
+	
+	// Show that there are columns off-screen
+	if m.colOffset > 0 || m.hasColumnsRight() {
+		tableContent += "\n\n" + m.renderColumnIndicator()
+	}
+	if m.droppedCols > 0 {
+		tableContent += "\n\n" + m.renderDroppedHint()
+	}
 	
 	// Add pagination if enabled
 	if m.showPagination {
-		tableContent += "\n\n" + m.renderPagination()
+		gap := "\n\n"
+		if m.dense {
+			gap = "\n"
+		}
+		tableContent += gap + m.renderPagination(lipgloss.Width(tableContent))
 	}
 	
 	// Apply border to entire table
-	s.WriteString(borderStyle.Render(tableContent))
+	s.WriteString(core.Box(tableContent, core.BoxOptions{PaddingX: 1}))
 	
-	// Help text at the bottom
-	helpText := "↑/↓: navigate rows • "
-	if m.showPagination {
-		helpText += "←/→: change page • "
+	// Refresh status
+	if m.refreshing {
+		s.WriteString("\n")
+		s.WriteString(flashStyle.Render(core.CurrentIcons().Refresh + " Refreshing..."))
+	} else if m.refreshErr != nil {
+		s.WriteString("\n")
+		s.WriteString(refreshErrorStyle.Render(core.CurrentIcons().Error + " Refresh failed: " + m.refreshErr.Error()))
+	}
+	if m.fetchErr != nil {
+		s.WriteString("\n")
+		s.WriteString(refreshErrorStyle.Render(core.CurrentIcons().Error + " Loading more failed: " + m.fetchErr.Error() + " (" + helpKeys(m.keys.NextPage) + " to retry)"))
+	}
+	
+	// Auto refresh status
+	if m.autoRefresh > 0 {
+		status := fmt.Sprintf("Last updated %ds ago • every %s", int(time.Since(m.lastUpdated).Seconds()), m.autoRefresh)
+		if m.paused {
+			status += " • paused"
+		}
+		s.WriteString("\n")
+		s.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(status))
+	}
+	
+	// Status message (copied, ...)
+	if m.flash != "" {
+		s.WriteString("\n")
+		s.WriteString(flashStyle.Render(m.flash))
+	}
+	
+	// Details of the selected row
+	if m.showDetails {
+		if row := m.selectedRow(); row != nil {
+			s.WriteString("\n")
+			s.WriteString(core.Box(m.detailView(row), infoBox))
+		}
+	}
+	
+	// Action menu for the picked row
+	if m.showActions {
+		s.WriteString("\n")
+		s.WriteString(m.renderActions())
+	}
+	
+	// Help text at the bottom, or the quit confirmation
+	helpText := m.shortHelp()
+	if m.confirmingQuit {
+		helpText = fmt.Sprintf("Discard %d selections? (y/n)", len(m.marked))
 	}
-	helpText += "q: quit"
 	
 	helpStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("241")).
 		MarginTop(1)
 	
+	// Search input, or the term being jumped through
+	if m.searching || m.searchTerm != "" {
+		s.WriteString("\n")
+		s.WriteString(helpStyle.Render(m.searchStatus()))
+		helpStyle = helpStyle.MarginTop(0)
+	}
+	
 	s.WriteString("\n")
 	s.WriteString(helpStyle.Render(helpText))
 	
 	return s.String()
 }
 
-// renderPagination creates the pagination controls
-func (m TableModel) renderPagination() string {
+// renderPagination creates the pagination controls, centered in width
+func (m TableModel) renderPagination(width int) string {
 	// Calculate range
-	startRow := m.currentPage*m.rowsPerPage + 1
-	endRow := startRow + len(m.table.Rows()) - 1
+	startRow := m.pager.Offset() + 1
+	endRow := startRow + len(m.pageEntries()) - 1
 	
 	// Button styles
 	activeButtonStyle := lipgloss.NewStyle().
@@ -211,71 +783,631 @@ func (m TableModel) renderPagination() string {
 	disabledButtonStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("238"))
 	
-	// Create pagination elements
-	var leftArrow, rightArrow string
+	pageInfo := fmt.Sprintf("%d-%d of %d", startRow, endRow, m.pager.Total)
+	if m.pager.Open {
+		// The total isn't known until the last page is in
+		pageInfo += "+"
+	}
+	if endRow < startRow {
+		pageInfo = fmt.Sprintf("page %d loading…", m.pager.Page+1)
+	}
 	
-	if m.currentPage > 0 {
-		leftArrow = activeButtonStyle.Render("◄ Previous")
-	} else {
-		leftArrow = disabledButtonStyle.Render("◄ Previous")
+	// Fall back to bare arrows when the labels don't fit
+	prevLabel, nextLabel := "◄ Previous", "Next ►"
+	if lipgloss.Width(prevLabel+pageInfo+nextLabel)+2 > width {
+		prevLabel, nextLabel = "◄", "►"
 	}
 	
-	if m.hasNextPage() {
-		rightArrow = activeButtonStyle.Render("Next ►")
-	} else {
-		rightArrow = disabledButtonStyle.Render("Next ►")
+	leftArrow := disabledButtonStyle.Render(prevLabel)
+	if m.pager.HasPrev() {
+		leftArrow = activeButtonStyle.Render(prevLabel)
+	}
+	rightArrow := disabledButtonStyle.Render(nextLabel)
+	if m.pager.HasNext() {
+		rightArrow = activeButtonStyle.Render(nextLabel)
 	}
 	
-	pageInfo := fmt.Sprintf("%d-%d of %d", startRow, endRow, m.totalRows)
+	// Measure the rendered widths so the styling escapes don't count
+	used := lipgloss.Width(leftArrow) + lipgloss.Width(pageInfo) + lipgloss.Width(rightArrow)
+	gap := (width - used) / 2
+	if gap > 5 {
+		gap = 5
+	}
+	if gap < 1 {
+		// Not even room for the arrows - the page info alone still helps
+		return lipgloss.PlaceHorizontal(width, lipgloss.Center, pageInfo)
+	}
 	
-	// Create centered pagination
-	paginationStyle := lipgloss.NewStyle().
-		Width(100).
-		Align(lipgloss.Center)
+	spacer := strings.Repeat(" ", gap)
+	bar := lipgloss.JoinHorizontal(lipgloss.Top, leftArrow, spacer, pageInfo, spacer, rightArrow)
+	
+	return lipgloss.PlaceHorizontal(width, lipgloss.Center, bar)
+}
+
+// updateActions handles keys while the row action menu is open
+func (m TableModel) updateActions(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.actionCursor > 0 {
+			m.actionCursor--
+		}
+	case "down", "j":
+		if m.actionCursor < len(m.actions)-1 {
+			m.actionCursor++
+		}
+	case "enter":
+		m.chosenRow = m.selectedRow()
+		m.chosenIndex = m.selectedIndex()
+		m.chosenAction = m.actions[m.actionCursor]
+		return m, tea.Quit
+	case "esc":
+		// Back to the table without choosing
+		m.showActions = false
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// renderActions draws the popup list of row actions
+func (m TableModel) renderActions() string {
+	var choices strings.Builder
 	
-	pagination := fmt.Sprintf("%s     %s     %s", leftArrow, pageInfo, rightArrow)
+	choices.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("229")).Render("Choose an action") + "\n")
+	for i, action := range m.actions {
+		cursor := "  "
+		actionText := lipgloss.NewStyle().Foreground(lipgloss.Color("252")).Render(action)
+		
+		if m.actionCursor == i {
+			cursor = lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Render(core.CurrentIcons().Cursor + " ")
+			actionText = lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Bold(true).Render(action)
+		}
+		
+		choices.WriteString("\n" + cursor + actionText)
+	}
 	
-	return paginationStyle.Render(pagination)
+	return core.Box(choices.String(), core.BoxOptions{Color: core.BoxMenuColor, PaddingX: 1})
+}
+
+// selectedIndex returns the index into allRows of the row under the cursor,
+// or -1 (also on a group header)
+func (m TableModel) selectedIndex() int {
+	entries := m.pageEntries()
+	cursor := m.pageCursor()
+	if cursor < 0 || cursor >= len(entries) {
+		return -1
+	}
+	return entries[cursor].index
+}
+
+// markedRows returns the marked rows in table order
+func (m TableModel) markedRows() []table.Row {
+	var rows []table.Row
+	for i, row := range m.allRows {
+		if m.marked[i] {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// remapMarks moves the marks on oldRows to the rows in newRows with the same
+// cells. Identical rows are matched one for one.
+func remapMarks(oldRows, newRows []table.Row, marked map[int]bool) map[int]bool {
+	remapped := map[int]bool{}
+	if len(marked) == 0 {
+		return remapped
+	}
+	pending := map[string]int{}
+	for index := range marked {
+		if index < len(oldRows) {
+			pending[strings.Join(oldRows[index], "\x00")]++
+		}
+	}
+	for i, row := range newRows {
+		if key := strings.Join(row, "\x00"); pending[key] > 0 {
+			pending[key]--
+			remapped[i] = true
+		}
+	}
+	return remapped
 }
 
-// Helper methods for pagination
-func (m *TableModel) hasNextPage() bool {
-	return (m.currentPage+1)*m.rowsPerPage < m.totalRows
+// selectedRow returns the full row under the cursor, accounting for the current page
+func (m TableModel) selectedRow() table.Row {
+	index := m.selectedIndex()
+	if index < 0 {
+		return nil
+	}
+	return m.allRows[index]
+}
+
+func autoRefreshTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return autoRefreshTickMsg(t)
+	})
+}
+
+// refresh runs RefreshFunc off the UI loop
+func (m TableModel) refresh() tea.Cmd {
+	refreshFunc := m.refreshFunc
+	return func() tea.Msg {
+		rows, err := refreshFunc()
+		return refreshMsg{rows: rows, err: err}
+	}
+}
+
+// setRows replaces all rows, e.g. after a refresh, staying on the current
+// page if it still exists. Marks follow their rows by content, since the
+// indexes into allRows shift; a mark on a row that changed or went away is
+// dropped.
+func (m *TableModel) setRows(rows []table.Row) {
+	m.marked = remapMarks(m.allRows, rows, m.marked)
+	m.allRows = rows
+	// The new rows are the whole set: FetchPage's pages belonged to the old
+	// ones, so stop lazy loading and drop a page still in flight
+	m.fetchedPages = 0
+	m.pager.Open = false
+	m.loadingMore = false
+	m.fetchErr = nil
+	m.regroup()
+	
+	m.pager.Goto(m.pager.Page)
+	
+	m.refreshView()
 }
 
 func (m *TableModel) updateTableRows() {
-	displayRows := getPageRows(m.allRows, m.currentPage, m.rowsPerPage)
-	m.table.SetRows(displayRows)
+	m.refreshView()
 	m.table.SetCursor(0) // Reset cursor to top of new page
+	m.followCursor()
+}
+
+// refreshView pushes the current page and the visible columns into the bubbles table
+func (m *TableModel) refreshView() {
+	m.updateVisibleColumns()
+	
+	entries := m.pageEntries()
+	positions, columns := m.shownColumns()
+	
+	// Remember the selected row before the visual rows are rebuilt
+	cursor := m.pageCursor()
+	
+	// Mark the focused column's header
+	for i, pos := range positions {
+		if pos == m.focusedCol {
+			columns[i].Title = core.CurrentIcons().Focus + columns[i].Title
+		}
+	}
+	visibleRows := make([]table.Row, 0, len(entries))
+	m.visualToRow = nil
+	m.rowStarts = nil
+	m.paint = nil
+	for i, entry := range entries {
+		m.rowStarts = append(m.rowStarts, len(visibleRows))
+		if entry.header() {
+			visibleRows = append(visibleRows, m.groupHeaderRow(entry, columns))
+			m.paint = append(m.paint, linePaint{header: true})
+			m.visualToRow = append(m.visualToRow, i)
+			continue
+		}
+		row := m.allRows[entry.index]
+		for l, line := range m.wrapRow(pickCells(m.formattedRow(row), positions), positions, columns) {
+			paint := linePaint{
+				// The selected row keeps its plain highlight
+				stripe: m.zebra && i%2 == 1 && i != cursor,
+				search: m.searchTerm != "",
+			}
+			line, paint.status = m.withLeadingCells(entry.index, row, line, l == 0)
+			visibleRows = append(visibleRows, line)
+			m.paint = append(m.paint, paint)
+			m.visualToRow = append(m.visualToRow, i)
+		}
+	}
+	if len(entries) == 0 && m.loadingMore {
+		// Not part of visualToRow, so it can never be selected
+		visibleRows = append(visibleRows, m.loadingRow(len(columns)))
+	}
+	
+	// Clear rows first - the bubbles table renders every cell of a row
+	// against the columns, so they have to match when columns change
+	m.table.SetRows(nil)
+	m.table.SetColumns(append(m.leadingColumns(), columns...))
+	m.table.SetRows(visibleRows)
+	if len(m.aggregates) > 0 {
+		m.footer = computeAggregates(m.allRows, m.aggregates)
+		m.footerLine = m.renderFooter(positions, columns)
+	}
+	if cursor < len(m.rowStarts) {
+		m.table.SetCursor(m.rowStarts[cursor])
+	}
+	m.followCursor()
+}
+
+// leadingColumns are the mark and status columns shown before the data columns
+func (m TableModel) leadingColumns() []table.Column {
+	var columns []table.Column
+	if m.multiSelect {
+		columns = append(columns, table.Column{Title: "", Width: markColumnWidth})
+	}
+	if m.statusFunc != nil {
+		columns = append(columns, table.Column{Title: "", Width: statusColumnWidth})
+	}
+	return columns
+}
+
+// withLeadingCells prepends the mark and status cells to a visual row, and
+// returns the status cell's style for renderTable. Wrapped continuation lines
+// get empty cells so they only show once per row.
+func (m TableModel) withLeadingCells(index int, row, line table.Row, first bool) (table.Row, *lipgloss.Style) {
+	var cells table.Row
+	if m.multiSelect {
+		mark := ""
+		if first && m.marked[index] {
+			mark = core.CurrentIcons().Mark
+		}
+		cells = append(cells, mark)
+	}
+	var statusStyle *lipgloss.Style
+	if m.statusFunc != nil {
+		status := ""
+		if first {
+			glyph, style := m.statusFunc(row)
+			status, statusStyle = glyph, &style
+		}
+		cells = append(cells, status)
+	}
+	if len(cells) == 0 {
+		return line, statusStyle
+	}
+	return append(cells, line...), statusStyle
+}
+
+// wrapRow splits a row into one or more visual rows, wrapping the cells of
+// columns with Wrap set. The other cells only appear on the first line.
+// positions are the display positions of the row's cells.
+func (m TableModel) wrapRow(row table.Row, positions []int, columns []table.Column) []table.Row {
+	if len(m.columnOptions) == 0 {
+		return []table.Row{row}
+	}
+	
+	cellLines := make([][]string, len(row))
+	height := 1
+	for i, value := range row {
+		if m.columnOptions[m.colOrder[positions[i]]].Wrap && columns[i].Width > 0 {
+			cellLines[i] = wrapText(value, columns[i].Width)
+		} else {
+			cellLines[i] = []string{value}
+		}
+		if len(cellLines[i]) > height {
+			height = len(cellLines[i])
+		}
+	}
+	
+	lines := make([]table.Row, height)
+	for l := range lines {
+		lines[l] = make(table.Row, len(row))
+		for i := range row {
+			if l < len(cellLines[i]) {
+				lines[l][i] = cellLines[i][l]
+			}
+		}
+	}
+	return lines
 }
 
-// getPageRows returns the rows for a specific page
-func getPageRows(allRows []table.Row, page, rowsPerPage int) []table.Row {
-	start := page * rowsPerPage
-	end := start + rowsPerPage
+// pageCursor returns the index on the current page of the row under the cursor
+func (m TableModel) pageCursor() int {
+	cursor := m.table.Cursor()
+	if cursor >= 0 && cursor < len(m.visualToRow) {
+		return m.visualToRow[cursor]
+	}
+	return cursor
+}
+
+// skipContinuationRows keeps the cursor on the first line of a wrapped row,
+// so the highlight always marks a whole row rather than one of its extra lines
+func (m *TableModel) skipContinuationRows(before int) {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.visualToRow) {
+		return
+	}
 	
-	if start >= len(allRows) {
-		return []table.Row{}
+	row := m.visualToRow[cursor]
+	if m.rowStarts[row] == cursor {
+		return
 	}
 	
-	if end > len(allRows) {
-		end = len(allRows)
+	// Moving down lands on the next row, moving up on the start of this one
+	if cursor > before && row+1 < len(m.rowStarts) {
+		m.table.SetCursor(m.rowStarts[row+1])
+	} else {
+		m.table.SetCursor(m.rowStarts[row])
+	}
+}
+
+// updateVisibleColumns works out how many columns from colOffset fit in the width
+func (m *TableModel) updateVisibleColumns() {
+	// Fitting shows every column there's room for, there's nothing to scroll
+	if m.fitToWidth {
+		m.colOffset = 0
+		m.visibleCols = len(m.colOrder)
+		return
+	}
+	if m.colOffset >= len(m.colOrder) {
+		m.colOffset = 0
 	}
 	
-	return allRows[start:end]
+	available := m.availableWidth()
+	used := 0
+	count := 0
+	for _, col := range m.displayColumns()[m.colOffset:] {
+		used += col.Width + m.cellPadding()
+		// Always show at least one column
+		if count > 0 && used > available {
+			break
+		}
+		count++
+	}
+	m.visibleCols = count
 }
 
-// ShowTable is a convenience function to display a table and wait for user interaction
+// availableWidth is the room the data columns have, inside the border and
+// after the mark and status columns
+func (m TableModel) availableWidth() int {
+	available := m.width - 4 // rounded border and padding
+	for _, col := range m.leadingColumns() {
+		available -= col.Width + m.cellPadding()
+	}
+	return available
+}
+
+// visibleOrder is the starting display order: every column not marked Hidden
+func visibleOrder(columns []table.Column, options map[int]ColumnOptions) []int {
+	order := make([]int, 0, len(columns))
+	for col := range columns {
+		if !options[col].Hidden {
+			order = append(order, col)
+		}
+	}
+	return order
+}
+
+// displayColumns returns the columns in display order
+func (m TableModel) displayColumns() []table.Column {
+	columns := make([]table.Column, len(m.colOrder))
+	for i, col := range m.colOrder {
+		columns[i] = m.allColumns[col]
+	}
+	return columns
+}
+
+// displayRow returns a row's cells in display order
+func (m TableModel) displayRow(row table.Row) table.Row {
+	cells := make(table.Row, len(m.colOrder))
+	for i, col := range m.colOrder {
+		if col < len(row) {
+			cells[i] = row[col]
+		}
+	}
+	return cells
+}
+
+// formattedRow is displayRow with the Formatters applied, what's drawn for the row
+func (m TableModel) formattedRow(row table.Row) table.Row {
+	cells := m.displayRow(row)
+	if len(m.formatters) == 0 {
+		return cells
+	}
+	for i, col := range m.colOrder {
+		if format := m.formatters[col]; format != nil {
+			cells[i] = format(cells[i])
+		}
+	}
+	return cells
+}
+
+// moveFocusedColumn swaps the focused column with its neighbour; focus moves with it
+func (m *TableModel) moveFocusedColumn(delta int) {
+	to := m.focusedCol + delta
+	if to < 0 || to >= len(m.colOrder) {
+		return
+	}
+	// Copy first, bubbletea models are values and must not share the order
+	order := append([]int(nil), m.colOrder...)
+	order[m.focusedCol], order[to] = order[to], order[m.focusedCol]
+	m.colOrder = order
+	m.focusedCol = to
+	m.scrollToFocusedColumn()
+}
+
+// cellPadding is the horizontal padding around each cell
+func (m TableModel) cellPadding() int {
+	if m.dense {
+		return 0
+	}
+	return 2
+}
+
+// scrollToFocusedColumn scrolls horizontally so the focused column is on screen
+func (m *TableModel) scrollToFocusedColumn() {
+	if m.focusedCol < m.colOffset {
+		m.colOffset = m.focusedCol
+	}
+	m.refreshView()
+	for m.focusedCol >= m.colOffset+m.visibleCols && m.hasColumnsRight() {
+		m.colOffset++
+		m.refreshView()
+	}
+}
+
+// copyToClipboard copies text and flashes the result. Headless environments
+// (CI, ssh without a clipboard) get a warning instead of an error.
+func (m *TableModel) copyToClipboard(text, what string) tea.Cmd {
+	if err := clipboard.WriteAll(text); err != nil {
+		return m.setFlash(core.CurrentIcons().Warning + " Clipboard unavailable: " + err.Error())
+	}
+	return m.setFlash(fmt.Sprintf("%s Copied %s", core.CurrentIcons().Success, what))
+}
+
+func (m TableModel) hasColumnsRight() bool {
+	return m.colOffset+m.visibleCols < len(m.colOrder)
+}
+
+// renderColumnIndicator shows which columns are on screen and which way there are more
+func (m TableModel) renderColumnIndicator() string {
+	activeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Bold(true)
+	disabledStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("238"))
+	
+	leftArrow := disabledStyle.Render("◄")
+	if m.colOffset > 0 {
+		leftArrow = activeStyle.Render("◄")
+	}
+	rightArrow := disabledStyle.Render("►")
+	if m.hasColumnsRight() {
+		rightArrow = activeStyle.Render("►")
+	}
+	
+	info := fmt.Sprintf("columns %d-%d of %d", m.colOffset+1, m.colOffset+m.visibleCols, len(m.colOrder))
+	return fmt.Sprintf("%s %s %s", leftArrow, info, rightArrow)
+}
+
+// pickCells returns the cells of row at positions, padding short rows with empty cells
+func pickCells(row table.Row, positions []int) table.Row {
+	picked := make(table.Row, len(positions))
+	for i, pos := range positions {
+		if pos < len(row) {
+			picked[i] = row[pos]
+		}
+	}
+	return picked
+}
+
+// wrapText word-wraps s to width, hard-breaking words that are longer than width
+func wrapText(s string, width int) []string {
+	wrapped := wrap.String(wordwrap.String(s, width), width)
+	return strings.Split(wrapped, "\n")
+}
+
+// ShowTable is a convenience function to display a table and wait for user interaction.
+// Without a terminal (piped, CI), or when the interactive table fails to
+// start, it prints the table instead, see SetTUIFallback.
 func ShowTable(config TableConfig) error {
+	caps := detectTerminal()
+	if !caps.tty {
+		return noTUI(config)
+	}
+	
 	model := New(config)
 	// Use alt screen for clean display
-	p := tea.NewProgram(model, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("error running table: %w", err)
+	if _, err := runProgram(model, caps.programOptions(config.Mouse)...); err != nil {
+		return startFailed(config, err)
 	}
 	return nil
 }
 
+// TableExitReason says how the user left a table run with RunTable
+type TableExitReason int
+
+const (
+	TableExitQuit     TableExitReason = iota // Quit without picking a row
+	TableExitSelected                        // Picked a row, no RowActions configured
+	TableExitAction                          // Picked a row and one of config.RowActions
+)
+
+func (r TableExitReason) String() string {
+	switch r {
+	case TableExitSelected:
+		return "selected"
+	case TableExitAction:
+		return "action"
+	}
+	return "quit"
+}
+
+// TableResult is what RunTable returns when the table closes
+type TableResult struct {
+	Reason TableExitReason
+	Index  int       // Index into config.Rows of the picked row, -1 on quit
+	Row    table.Row // The picked row, nil on quit
+	Action string    // The chosen action with TableExitAction
+}
+
+// RunTable displays the table as a picker and reports how it was closed, so
+// callers can branch on quit vs. a picked row vs. a chosen action. Quitting
+// is not an error.
+func RunTable(config TableConfig) (TableResult, error) {
+	// There's no picking a row without a terminal, so no plain fallback
+	caps := detectTerminal()
+	if !caps.tty {
+		return TableResult{Index: -1}, ErrNoTUI
+	}
+	
+	model := New(config)
+	model.selectMode = true
+	model.chosenIndex = -1
+	
+	finalModel, err := runProgram(model, caps.programOptions(config.Mouse)...)
+	if err != nil {
+		return TableResult{Index: -1}, fmt.Errorf("error running table: %w", err)
+	}
+	
+	m := finalModel.(TableModel)
+	result := TableResult{Reason: TableExitQuit, Index: -1}
+	if m.chosenRow == nil {
+		return result, nil
+	}
+	
+	result.Row = m.chosenRow
+	result.Index = m.chosenIndex
+	result.Reason = TableExitSelected
+	if m.chosenAction != "" {
+		result.Action = m.chosenAction
+		result.Reason = TableExitAction
+	}
+	return result, nil
+}
+
+// ShowTableSelect displays the table as a picker. On enter it returns the selected row
+// (all its cells, Hidden columns included), and
+// if config.RowActions is set, the action chosen for it from a small popup menu.
+func ShowTableSelect(config TableConfig) (table.Row, string, error) {
+	result, err := RunTable(config)
+	if err != nil {
+		return nil, "", err
+	}
+	if result.Reason == TableExitQuit {
+		return nil, "", fmt.Errorf("cancelled")
+	}
+	
+	return result.Row, result.Action, nil
+}
+
+// ShowTableMultiSelect lets the user mark rows with 'x' and returns the marked
+// rows on enter. With config.ConfirmQuit, quitting with rows marked asks first.
+func ShowTableMultiSelect(config TableConfig) ([]table.Row, error) {
+	caps := detectTerminal()
+	if !caps.tty {
+		return nil, ErrNoTUI
+	}
+	
+	model := New(config)
+	model.multiSelect = true
+	model.refreshView() // Add the mark column
+	
+	finalModel, err := runProgram(model, caps.programOptions(config.Mouse)...)
+	if err != nil {
+		return nil, fmt.Errorf("error running table: %w", err)
+	}
+	
+	m := finalModel.(TableModel)
+	if m.chosenRows == nil {
+		return nil, fmt.Errorf("cancelled")
+	}
+	
+	return m.chosenRows, nil
+}
+
 // ShowTableWithColumnSeparators shows a table with visual column separators
 // Uses lipgloss table for better column separation
 func ShowTableWithColumnSeparators(config TableConfig) error {
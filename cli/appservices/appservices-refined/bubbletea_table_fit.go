@@ -0,0 +1,115 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Narrowest a column shrinks to when fitting by Priority, unless its MinWidth says otherwise
+const minFitWidth = 6
+
+// hasPriorities reports whether any column has a Priority, which turns fitting on
+func hasPriorities(options map[int]ColumnOptions) bool {
+	for _, opt := range options {
+		if opt.Priority != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// shownColumns returns the display positions and (possibly shrunk) columns
+// that go into the bubbles table: the scrolled-to slice normally, or what
+// fitColumns keeps when fitting to the width
+func (m *TableModel) shownColumns() ([]int, []table.Column) {
+	display := m.displayColumns()
+	if m.fitToWidth {
+		return m.fitColumns(display)
+	}
+
+	positions := make([]int, 0, m.visibleCols)
+	columns := make([]table.Column, 0, m.visibleCols)
+	for pos := m.colOffset; pos < m.colOffset+m.visibleCols; pos++ {
+		positions = append(positions, pos)
+		columns = append(columns, display[pos])
+	}
+	return positions, columns
+}
+
+// fitColumns squeezes the columns into the width. Columns give way lowest
+// Priority first, rightmost first among equals: first each shrinks down to
+// its MinWidth, then, if that's not enough, they are dropped one by one.
+// At least one column always stays.
+func (m *TableModel) fitColumns(display []table.Column) ([]int, []table.Column) {
+	widths := make([]int, len(display))
+	total := 0
+	for pos, col := range display {
+		widths[pos] = col.Width
+		total += col.Width + m.cellPadding()
+	}
+
+	// The order columns give way in
+	yield := make([]int, len(display))
+	for pos := range yield {
+		yield[pos] = pos
+	}
+	priority := func(pos int) int {
+		return m.columnOptions[m.colOrder[pos]].Priority
+	}
+	sort.SliceStable(yield, func(i, j int) bool {
+		if priority(yield[i]) != priority(yield[j]) {
+			return priority(yield[i]) < priority(yield[j])
+		}
+		return yield[i] > yield[j]
+	})
+
+	available := m.availableWidth()
+	for _, pos := range yield {
+		if total <= available {
+			break
+		}
+		minWidth := m.columnOptions[m.colOrder[pos]].MinWidth
+		if minWidth <= 0 {
+			minWidth = minFitWidth
+		}
+		if shrink := widths[pos] - minWidth; shrink > 0 {
+			if over := total - available; shrink > over {
+				shrink = over
+			}
+			widths[pos] -= shrink
+			total -= shrink
+		}
+	}
+
+	dropped := make([]bool, len(display))
+	m.droppedCols = 0
+	for _, pos := range yield {
+		if total <= available || m.droppedCols == len(display)-1 {
+			break
+		}
+		dropped[pos] = true
+		m.droppedCols++
+		total -= widths[pos] + m.cellPadding()
+	}
+
+	var positions []int
+	var columns []table.Column
+	for pos, col := range display {
+		if dropped[pos] {
+			continue
+		}
+		col.Width = widths[pos]
+		positions = append(positions, pos)
+		columns = append(columns, col)
+	}
+	return positions, columns
+}
+
+// renderDroppedHint says how many columns were left out to fit the width
+func (m TableModel) renderDroppedHint() string {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).
+		Render(fmt.Sprintf("+%d more columns (widen the terminal or press %s for details)", m.droppedCols, m.keys.OpenPager.Help().Key))
+}
@@ -0,0 +1,39 @@
+package table
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// How long a flash stays under the table
+const flashDuration = 3 * time.Second
+
+// flashExpiredMsg clears the flash it was started for. A newer flash has a
+// newer id, so an old tick doesn't cut it short.
+type flashExpiredMsg struct {
+	id int
+}
+
+// setFlash shows msg under the table and returns the tick that clears it.
+// Use it for every transient message (copied, exported, refreshed, ...).
+func (m *TableModel) setFlash(msg string) tea.Cmd {
+	m.flash = msg
+	m.flashID++
+	return m.flashTick()
+}
+
+// flashTick clears the current flash after flashDuration
+func (m TableModel) flashTick() tea.Cmd {
+	id := m.flashID
+	return tea.Tick(flashDuration, func(time.Time) tea.Msg {
+		return flashExpiredMsg{id: id}
+	})
+}
+
+// flashExpired clears the flash if msg is for the current one
+func (m *TableModel) flashExpired(msg flashExpiredMsg) {
+	if msg.id == m.flashID {
+		m.flash = ""
+	}
+}
@@ -0,0 +1,173 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// Group header row style
+var groupHeaderStyle = lipgloss.NewStyle().
+	Bold(true).
+	Foreground(lipgloss.Color("229"))
+
+// groupEntry is one line of a grouped table: a group header or one of the
+// group's rows. Without GroupBy every line is a row.
+type groupEntry struct {
+	group string // The GroupBy column's value
+	index int    // Index into allRows, -1 for the header
+	count int    // Rows in the group, set on the header
+}
+
+// header reports whether the line is a group header
+func (e groupEntry) header() bool {
+	return e.index < 0
+}
+
+// groupEntries partitions rows by their value in column col, groups in
+// order of first appearance and rows in their original order. Collapsed
+// groups only get their header.
+func groupEntries(rows []table.Row, col int, collapsed map[string]bool) []groupEntry {
+	var order []string
+	members := map[string][]int{}
+	for i, row := range rows {
+		value := groupValue(row, col)
+		if _, ok := members[value]; !ok {
+			order = append(order, value)
+		}
+		members[value] = append(members[value], i)
+	}
+
+	entries := make([]groupEntry, 0, len(order)+len(rows))
+	for _, group := range order {
+		entries = append(entries, groupEntry{group: group, index: -1, count: len(members[group])})
+		if collapsed[group] {
+			continue
+		}
+		for _, index := range members[group] {
+			entries = append(entries, groupEntry{group: group, index: index})
+		}
+	}
+	return entries
+}
+
+// groupValue is the cell rows are grouped by, empty for short rows
+func groupValue(row table.Row, col int) string {
+	if col < len(row) {
+		return row[col]
+	}
+	return ""
+}
+
+// grouped reports whether the rows are shown in GroupBy sections
+func (m TableModel) grouped() bool {
+	return m.groupBy >= 0
+}
+
+// regroup rebuilds the lines after the rows or the collapsed groups change.
+// The pager counts lines, so headers take room on a page and the rows of
+// collapsed groups don't.
+func (m *TableModel) regroup() {
+	total := len(m.allRows)
+	if m.grouped() {
+		m.entries = groupEntries(m.allRows, m.groupBy, m.collapsed)
+		total = len(m.entries)
+	}
+	m.pager.Total = total
+	if !m.showPagination {
+		m.pager.PageSize = total
+	}
+}
+
+// pageEntries returns the lines on the current page
+func (m TableModel) pageEntries() []groupEntry {
+	if m.grouped() {
+		start, end := m.pager.Bounds(len(m.entries))
+		return m.entries[start:end]
+	}
+	start, end := m.pager.Bounds(len(m.allRows))
+	entries := make([]groupEntry, 0, end-start)
+	for i := start; i < end; i++ {
+		entries = append(entries, groupEntry{index: i})
+	}
+	return entries
+}
+
+// onGroupHeader reports whether the cursor is on a group header
+func (m TableModel) onGroupHeader() bool {
+	entries := m.pageEntries()
+	cursor := m.pageCursor()
+	return m.grouped() && cursor >= 0 && cursor < len(entries) && entries[cursor].header()
+}
+
+// toggleGroup collapses or expands the group under the cursor. The header
+// stays where it is, so the cursor stays on it.
+func (m *TableModel) toggleGroup() {
+	entries := m.pageEntries()
+	cursor := m.pageCursor()
+	if cursor < 0 || cursor >= len(entries) {
+		return
+	}
+	m.setCollapsed(entries[cursor].group, !m.collapsed[entries[cursor].group])
+	m.refreshView()
+}
+
+// setCollapsed collapses or expands group and rebuilds the lines
+func (m *TableModel) setCollapsed(group string, collapsed bool) {
+	// Copy first, bubbletea models are values and must not share the map
+	groups := make(map[string]bool, len(m.collapsed)+1)
+	for g, c := range m.collapsed {
+		groups[g] = c
+	}
+	if collapsed {
+		groups[group] = true
+	} else {
+		delete(groups, group)
+	}
+	m.collapsed = groups
+	m.regroup()
+	m.pager.Goto(m.pager.Page)
+}
+
+// revealRow returns the line the row at index (into allRows) is on,
+// expanding its group first if it's collapsed
+func (m *TableModel) revealRow(index int) int {
+	if !m.grouped() {
+		return index
+	}
+	if group := groupValue(m.allRows[index], m.groupBy); m.collapsed[group] {
+		m.setCollapsed(group, false)
+	}
+	for line, entry := range m.entries {
+		if entry.index == index {
+			return line
+		}
+	}
+	return 0
+}
+
+// groupHeaderRow draws a group header: the group's value and row count in
+// the first shown column, bold, with the expanded/collapsed marker
+func (m TableModel) groupHeaderRow(entry groupEntry, columns []table.Column) table.Row {
+	lead := len(m.leadingColumns())
+	row := make(table.Row, lead+len(columns))
+	if len(columns) == 0 {
+		return row
+	}
+
+	icons := core.CurrentIcons()
+	marker := icons.Expanded
+	if m.collapsed[entry.group] {
+		marker = icons.Collapsed
+	}
+	name := entry.group
+	if name == "" {
+		name = "(none)"
+	}
+	// Plain text, renderTable styles it once it's cut to the column
+	row[lead] = fmt.Sprintf("%s %s (%d)", marker, name, entry.count)
+	return row
+}
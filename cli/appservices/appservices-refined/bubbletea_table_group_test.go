@@ -0,0 +1,51 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+func TestNewGroupBy(t *testing.T) {
+	rows := []table.Row{{"cache", "orders"}, {"db", "billing"}, {"cache", "billing"}}
+	tests := []struct {
+		name      string
+		groupRows bool
+		groupBy   int
+		wantLines int // Headers plus rows
+	}{
+		{"off", false, 0, 3},
+		{"first column", true, 0, 5},
+		{"second column", true, 1, 5},
+		{"off ignores GroupBy", false, 1, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(TableConfig{
+				Columns:   []table.Column{{Title: "Type", Width: 10}, {Title: "Name", Width: 10}},
+				Rows:      rows,
+				GroupRows: tt.groupRows,
+				GroupBy:   tt.groupBy,
+			})
+			if m.grouped() != tt.groupRows {
+				t.Fatalf("grouped() = %v, want %v", m.grouped(), tt.groupRows)
+			}
+			if m.pager.Total != tt.wantLines {
+				t.Errorf("%d lines, want %d", m.pager.Total, tt.wantLines)
+			}
+			if tt.groupRows && m.entries[0].group != rows[0][tt.groupBy] {
+				t.Errorf("first group = %q, want %q", m.entries[0].group, rows[0][tt.groupBy])
+			}
+		})
+	}
+}
+
+func TestGroupHeaderInColor(t *testing.T) {
+	inColor(t)
+	m := New(TableConfig{
+		Columns:   []table.Column{{Title: "Env", Width: 12}, {Title: "Name", Width: 10}},
+		Rows:      []table.Row{{"prod", "orders"}, {"test", "billing"}, {"prod", "search"}},
+		GroupRows: true,
+	})
+	checkView(t, m.View(), "prod (2)", "test (1)", "orders", "billing")
+}
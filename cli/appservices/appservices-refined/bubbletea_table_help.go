@@ -0,0 +1,148 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// keyBinding describes a key for the help line and the '?' overlay
+type keyBinding struct {
+	keys  string
+	desc  string
+	short bool // Also shown in the one-line help under the table
+}
+
+// keyBindings lists the keys handled by Update, given the current config and KeyMap.
+// Keep this in sync when adding keys to Update.
+func (m TableModel) keyBindings() []keyBinding {
+	if m.showActions {
+		return []keyBinding{
+			{keys: "↑/↓", desc: "choose action", short: true},
+			{keys: "enter", desc: "confirm action", short: true},
+			{keys: "esc", desc: "back to the table", short: true},
+			{keys: "q", desc: "quit", short: true},
+		}
+	}
+
+	bindings := []keyBinding{
+		{keys: "↑/↓", desc: "navigate rows", short: true},
+	}
+	add := func(desc string, short bool, keys ...key.Binding) {
+		if label := helpKeys(keys...); label != "" {
+			bindings = append(bindings, keyBinding{keys: label, desc: desc, short: short})
+		}
+	}
+
+	k := m.keys
+	if m.showPagination {
+		add("change page", true, k.PrevPage, k.NextPage)
+	}
+	if m.colOffset > 0 || m.hasColumnsRight() {
+		add("scroll columns", true, k.ScrollLeft, k.ScrollRight)
+	}
+	if m.detailFunc != nil {
+		if m.selectMode || m.multiSelect {
+			add("toggle details", true, k.ToggleDetails)
+		} else {
+			add("toggle details", true, k.Select, k.ToggleDetails)
+		}
+	}
+	if m.grouped() {
+		add("collapse/expand group", true, k.Select)
+	}
+	if m.selectMode {
+		add("select row", true, k.Select)
+	}
+	if m.multiSelect {
+		add(k.Mark.Help().Desc, true, k.Mark)
+		add("confirm marked rows", true, k.Select)
+	}
+	add("focus next/previous column", false, k.NextColumn, k.PrevColumn)
+	add("move focused column left/right", false, k.MoveColumnLeft, k.MoveColumnRight)
+	add(k.CopyRow.Help().Desc, false, k.CopyRow)
+	add(k.CopyCell.Help().Desc, false, k.CopyCell)
+	add(k.CopyMarkdown.Help().Desc, false, k.CopyMarkdown)
+	add(k.OpenPager.Help().Desc, false, k.OpenPager)
+	add(k.Search.Help().Desc, false, k.Search)
+	if m.searchTerm != "" {
+		add("next/previous match", true, k.NextMatch, k.PrevMatch)
+	}
+	add(k.ToggleDense.Help().Desc, false, k.ToggleDense)
+	if m.refreshFunc != nil {
+		add(k.Refresh.Help().Desc, false, k.Refresh)
+	}
+	if m.autoRefresh > 0 {
+		add(k.Pause.Help().Desc, false, k.Pause)
+	}
+	add(k.Help.Help().Desc, true, k.Help)
+	add(k.Quit.Help().Desc, true, k.Quit)
+	return bindings
+}
+
+// helpKeys joins the help keys of the enabled bindings, e.g. "←/→"
+func helpKeys(bindings ...key.Binding) string {
+	var keys []string
+	for _, b := range bindings {
+		if b.Enabled() {
+			keys = append(keys, b.Help().Key)
+		}
+	}
+	return strings.Join(keys, "/")
+}
+
+// shortHelp renders the one-line help under the table. On a terminal too
+// narrow for it, only the keys are shown, wrapped if even those don't fit.
+func (m TableModel) shortHelp() string {
+	var parts, keys []string
+	for _, b := range m.keyBindings() {
+		if b.short {
+			parts = append(parts, b.keys+": "+b.desc)
+			keys = append(keys, b.keys)
+		}
+	}
+
+	full := strings.Join(parts, " • ")
+	if m.width <= 0 || lipgloss.Width(full) <= m.width {
+		return full
+	}
+	compact := strings.Join(keys, " ")
+	if lipgloss.Width(compact) <= m.width {
+		return compact
+	}
+	return wordwrap.String(compact, m.width)
+}
+
+// renderHelp renders the full screen help overlay
+func (m TableModel) renderHelp() string {
+	bindings := m.keyBindings()
+
+	keyWidth := 0
+	for _, b := range bindings {
+		if w := lipgloss.Width(b.keys); w > keyWidth {
+			keyWidth = w
+		}
+	}
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("229")).
+		Bold(true).
+		Width(keyWidth + 2)
+
+	var lines strings.Builder
+	lines.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("229")).Render("Key bindings") + "\n")
+	for _, b := range bindings {
+		lines.WriteString(fmt.Sprintf("\n%s%s", keyStyle.Render(b.keys), b.desc))
+	}
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("241")).
+		MarginTop(1)
+
+	return core.Box(lines.String(), core.BoxOptions{PaddingY: 1, PaddingX: 2}) + "\n" + hintStyle.Render(helpKeys(m.keys.Help)+"/esc: close help • "+helpKeys(m.keys.Quit)+": quit")
+}
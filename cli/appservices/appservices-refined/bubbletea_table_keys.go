@@ -0,0 +1,103 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap holds the keys TableModel handles itself. Row up/down is left to the
+// bubbles table. Any binding left unset in TableConfig.KeyMap keeps its
+// default, so callers only set the ones they want to change, e.g.
+//
+//	KeyMap: table.KeyMap{
+//		PrevPage: key.NewBinding(key.WithKeys("left"), key.WithHelp("←", "previous page")),
+//	}
+//
+// Disable a binding with SetEnabled(false).
+type KeyMap struct {
+	Quit            key.Binding
+	Help            key.Binding
+	NextColumn      key.Binding // Focus the next column
+	PrevColumn      key.Binding
+	MoveColumnLeft  key.Binding // Move the focused column
+	MoveColumnRight key.Binding
+	ScrollLeft      key.Binding // Scroll columns
+	ScrollRight     key.Binding
+	PrevPage        key.Binding
+	NextPage        key.Binding
+	Refresh         key.Binding
+	Pause           key.Binding // Pause/resume auto refresh
+	CopyRow         key.Binding
+	CopyCell        key.Binding
+	CopyMarkdown    key.Binding // Copy all rows as a Markdown table
+	ToggleDense     key.Binding
+	ToggleDetails   key.Binding
+	Select          key.Binding // Picks the row in select mode, otherwise also toggles details
+	Mark            key.Binding // Marks a row in ShowTableMultiSelect
+	OpenPager       key.Binding // Opens the selected row's details in $PAGER
+	Search          key.Binding
+	NextMatch       key.Binding // Jump to the next row matching the search
+	PrevMatch       key.Binding
+}
+
+// DefaultKeyMap returns the default table bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Quit:            key.NewBinding(key.WithKeys("q", "ctrl+c", "esc"), key.WithHelp("q/esc", "quit")),
+		Help:            key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle this help")),
+		NextColumn:      key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "focus next column")),
+		PrevColumn:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "focus previous column")),
+		MoveColumnLeft:  key.NewBinding(key.WithKeys("<"), key.WithHelp("<", "move column left")),
+		MoveColumnRight: key.NewBinding(key.WithKeys(">"), key.WithHelp(">", "move column right")),
+		ScrollLeft:      key.NewBinding(key.WithKeys("shift+left", "H"), key.WithHelp("shift+←", "scroll columns left")),
+		ScrollRight:     key.NewBinding(key.WithKeys("shift+right", "L"), key.WithHelp("shift+→", "scroll columns right")),
+		PrevPage:        key.NewBinding(key.WithKeys("left", "h", "pgup"), key.WithHelp("←", "previous page")),
+		NextPage:        key.NewBinding(key.WithKeys("right", "l", "pgdown"), key.WithHelp("→", "next page")),
+		Refresh:         key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+		Pause:           key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "pause/resume auto refresh")),
+		CopyRow:         key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy row")),
+		CopyCell:        key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "copy focused cell")),
+		CopyMarkdown:    key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "copy table as Markdown")),
+		ToggleDense:     key.NewBinding(key.WithKeys("D"), key.WithHelp("D", "toggle dense view")),
+		ToggleDetails:   key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle details")),
+		Select:          key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select row")),
+		Mark:            key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "mark row")),
+		OpenPager:       key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "view details in $PAGER")),
+		Search:          key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
+		NextMatch:       key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "next match")),
+		PrevMatch:       key.NewBinding(key.WithKeys("N"), key.WithHelp("N", "previous match")),
+	}
+}
+
+// withDefaults fills every unset binding from DefaultKeyMap
+func (k KeyMap) withDefaults() KeyMap {
+	d := DefaultKeyMap()
+	fill := func(b *key.Binding, def key.Binding) {
+		if len(b.Keys()) == 0 {
+			*b = def
+		}
+	}
+	fill(&k.Quit, d.Quit)
+	fill(&k.Help, d.Help)
+	fill(&k.NextColumn, d.NextColumn)
+	fill(&k.PrevColumn, d.PrevColumn)
+	fill(&k.MoveColumnLeft, d.MoveColumnLeft)
+	fill(&k.MoveColumnRight, d.MoveColumnRight)
+	fill(&k.ScrollLeft, d.ScrollLeft)
+	fill(&k.ScrollRight, d.ScrollRight)
+	fill(&k.PrevPage, d.PrevPage)
+	fill(&k.NextPage, d.NextPage)
+	fill(&k.Refresh, d.Refresh)
+	fill(&k.Pause, d.Pause)
+	fill(&k.CopyRow, d.CopyRow)
+	fill(&k.CopyCell, d.CopyCell)
+	fill(&k.CopyMarkdown, d.CopyMarkdown)
+	fill(&k.ToggleDense, d.ToggleDense)
+	fill(&k.ToggleDetails, d.ToggleDetails)
+	fill(&k.Select, d.Select)
+	fill(&k.Mark, d.Mark)
+	fill(&k.OpenPager, d.OpenPager)
+	fill(&k.Search, d.Search)
+	fill(&k.NextMatch, d.NextMatch)
+	fill(&k.PrevMatch, d.PrevMatch)
+	return k
+}
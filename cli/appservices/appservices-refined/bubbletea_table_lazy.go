@@ -0,0 +1,79 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// pageLoadedMsg carries a page from FetchPage back to Update
+type pageLoadedMsg struct {
+	rows []table.Row
+	more bool
+	err  error
+}
+
+// fetchPageCmd runs FetchPage for the given page off the UI loop
+func (m TableModel) fetchPageCmd(page int) tea.Cmd {
+	fetch := m.fetchPage
+	return func() tea.Msg {
+		rows, more, err := fetch(page)
+		return pageLoadedMsg{rows: rows, more: more, err: err}
+	}
+}
+
+// fetchNextPage starts loading the next unfetched page, unless one is
+// already in flight or there are no more
+func (m *TableModel) fetchNextPage() tea.Cmd {
+	if !m.pager.Open || m.loadingMore {
+		return nil
+	}
+	m.loadingMore = true
+	m.fetchErr = nil
+	m.refreshView() // Show the loading row
+	return m.fetchPageCmd(m.fetchedPages)
+}
+
+// needsPage reports whether the current table page isn't full yet and
+// FetchPage has more to give
+func (m TableModel) needsPage() bool {
+	return m.pager.Open && (m.pager.Page+1)*m.pager.PageSize > m.pager.Total
+}
+
+// pageLoaded caches a fetched page and keeps fetching until the current
+// table page is full, since API pages needn't match the table's page size
+func (m TableModel) pageLoaded(msg pageLoadedMsg) (TableModel, tea.Cmd) {
+	if !m.loadingMore {
+		// setRows replaced the rows while this page was in flight
+		return m, nil
+	}
+	m.loadingMore = false
+	if msg.err != nil {
+		// Keep what we have, paging forward again retries this page
+		m.fetchErr = msg.err
+		m.refreshView()
+		return m, nil
+	}
+
+	m.fetchedPages++
+	// An empty page claiming there's more would have us fetching forever
+	m.pager.Open = msg.more && len(msg.rows) > 0
+	m.allRows = append(m.allRows, msg.rows...)
+	m.regroup()
+	m.refreshView()
+
+	if m.needsPage() {
+		return m, m.fetchNextPage()
+	}
+	return m, nil
+}
+
+// loadingRow is the placeholder shown on a page whose rows are still in flight
+func (m TableModel) loadingRow(columns int) table.Row {
+	row := make(table.Row, len(m.leadingColumns())+columns)
+	if columns > 0 {
+		row[len(m.leadingColumns())] = core.CurrentIcons().Refresh + " loading…"
+	}
+	return row
+}
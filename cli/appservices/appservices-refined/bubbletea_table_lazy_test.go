@@ -0,0 +1,48 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+func lazyModel(t *testing.T) TableModel {
+	t.Helper()
+	return New(TableConfig{
+		Columns:        []table.Column{{Title: "Name", Width: 10}},
+		RowsPerPage:    2,
+		ShowPagination: true,
+		FetchPage: func(page int) ([]table.Row, bool, error) {
+			return []table.Row{{"a"}, {"b"}}, true, nil
+		},
+	})
+}
+
+func TestPageLoadedCountsPages(t *testing.T) {
+	m := lazyModel(t)
+	m, _ = m.pageLoaded(pageLoadedMsg{rows: []table.Row{{"a"}, {"b"}}, more: true})
+	if m.fetchedPages != 1 || !m.pager.Open || len(m.allRows) != 2 {
+		t.Fatalf("after one page: fetchedPages = %d, Open = %v, %d rows", m.fetchedPages, m.pager.Open, len(m.allRows))
+	}
+}
+
+func TestSetRowsResetsLazyLoading(t *testing.T) {
+	m := lazyModel(t)
+	m, _ = m.pageLoaded(pageLoadedMsg{rows: []table.Row{{"a"}, {"b"}}, more: true})
+	m.loadingMore = true // The next page is in flight
+
+	m.setRows([]table.Row{{"x"}, {"y"}, {"z"}})
+	if m.fetchedPages != 0 || m.pager.Open || m.loadingMore {
+		t.Errorf("after setRows: fetchedPages = %d, Open = %v, loadingMore = %v, want 0, false, false",
+			m.fetchedPages, m.pager.Open, m.loadingMore)
+	}
+
+	// The page that was in flight belongs to the old rows
+	m, cmd := m.pageLoaded(pageLoadedMsg{rows: []table.Row{{"c"}, {"d"}}, more: true})
+	if len(m.allRows) != 3 || cmd != nil {
+		t.Errorf("stale page was applied: %d rows, cmd %v", len(m.allRows), cmd)
+	}
+	if m.fetchNextPage() != nil {
+		t.Error("fetchNextPage() started a fetch after the rows were replaced")
+	}
+}
@@ -0,0 +1,125 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// dataLoadedMsg carries the result of the load function back to loadingModel
+type dataLoadedMsg struct {
+	config TableConfig
+	err    error
+}
+
+// loadingModel shows a spinner while the table's data is fetched, then hands
+// over to the table once a dataLoadedMsg arrives
+type loadingModel struct {
+	spinner   spinner.Model
+	message   string
+	load      func() (TableConfig, error)
+	err       error
+	cancelled bool
+	size      *tea.WindowSizeMsg // Replayed to the table so it starts at the right size
+	mouseOK   bool               // The terminal supports mouse events, for TableConfig.Mouse
+}
+
+func newLoadingModel(message string, load func() (TableConfig, error)) loadingModel {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("62"))
+
+	return loadingModel{
+		spinner: s,
+		message: message,
+		load:    load,
+	}
+}
+
+// Init starts the spinner and the fetch together
+func (m loadingModel) Init() tea.Cmd {
+	load := m.load
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		config, err := load()
+		return dataLoadedMsg{config: config, err: err}
+	})
+}
+
+func (m loadingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			m.cancelled = true
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.size = &msg
+
+	case dataLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+
+		// Swap in the table, it takes over as the program's model from here
+		table := New(msg.config)
+		if m.size != nil {
+			updated, _ := table.Update(*m.size)
+			table = updated.(TableModel)
+		}
+		if msg.config.Mouse && m.mouseOK {
+			return table, tea.Batch(table.Init(), tea.EnableMouseCellMotion)
+		}
+		return table, table.Init()
+	}
+
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+func (m loadingModel) View() string {
+	hintStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("241")).
+		MarginTop(1)
+
+	return fmt.Sprintf("\n %s %s\n", m.spinner.View(), m.message) + hintStyle.Render(" q/esc: cancel")
+}
+
+// ShowTableLoading starts the table UI straight away with a loading spinner,
+// runs load in the background and shows the table once it returns.
+// The user can cancel while loading, which returns a "cancelled" error.
+// Without a terminal it just loads and prints the table, like ShowTable.
+func ShowTableLoading(message string, load func() (TableConfig, error)) error {
+	caps := detectTerminal()
+	if !caps.tty {
+		if !tuiFallback {
+			return ErrNoTUI
+		}
+		config, err := load()
+		if err != nil {
+			return err
+		}
+		return noTUI(config)
+	}
+
+	model := newLoadingModel(message, load)
+	model.mouseOK = caps.mouse
+	finalModel, err := runProgram(model, caps.programOptions(false)...)
+	if err != nil {
+		return fmt.Errorf("error running table: %w", err)
+	}
+
+	// Still loading when the program ended: either cancelled or load failed
+	if m, ok := finalModel.(loadingModel); ok {
+		if m.err != nil {
+			return m.err
+		}
+		return fmt.Errorf("cancelled")
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package table
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// markdownFile is written instead when the clipboard isn't available
+const markdownFile = "table.md"
+
+// RowsToMarkdown formats the rows as a GitHub-flavored Markdown table.
+// Columns are padded to the widest cell so the source lines up too.
+func RowsToMarkdown(columns []table.Column, rows []table.Row) string {
+	widths := make([]int, len(columns))
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = escapeMarkdownCell(col.Title)
+		// The separator needs at least three dashes
+		widths[i] = 3
+		if w := runewidth.StringWidth(header[i]); w > widths[i] {
+			widths[i] = w
+		}
+	}
+
+	cells := make([][]string, len(rows))
+	for r, row := range rows {
+		cells[r] = make([]string, len(columns))
+		for i := range columns {
+			if i < len(row) {
+				cells[r][i] = escapeMarkdownCell(row[i])
+			}
+			if w := runewidth.StringWidth(cells[r][i]); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	var b strings.Builder
+	writeLine := func(values []string) {
+		b.WriteString("|")
+		for i, v := range values {
+			b.WriteString(" " + runewidth.FillRight(v, widths[i]) + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeLine(header)
+	b.WriteString("|")
+	for _, w := range widths {
+		b.WriteString(" " + strings.Repeat("-", w) + " |")
+	}
+	b.WriteString("\n")
+	for _, row := range cells {
+		writeLine(row)
+	}
+	return b.String()
+}
+
+// escapeMarkdownCell keeps a value inside its cell
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// copyMarkdown copies every row (not just the current page) as Markdown,
+// falling back to writing markdownFile when there's no clipboard
+func (m *TableModel) copyMarkdown() tea.Cmd {
+	// Export the shown columns in the current order, with raw values (Formatters are display only)
+	rows := make([]table.Row, len(m.allRows))
+	for i, row := range m.allRows {
+		rows[i] = m.displayRow(row)
+	}
+	md := RowsToMarkdown(m.displayColumns(), rows)
+	if err := clipboard.WriteAll(md); err == nil {
+		return m.setFlash(fmt.Sprintf("%s Copied %d rows as Markdown", core.CurrentIcons().Success, len(m.allRows)))
+	}
+	if err := os.WriteFile(markdownFile, []byte(md), 0o644); err != nil {
+		return m.setFlash(core.CurrentIcons().Warning + " Couldn't export Markdown: " + err.Error())
+	}
+	return m.setFlash(fmt.Sprintf("%s Clipboard unavailable, saved %d rows to %s", core.CurrentIcons().Success, len(m.allRows), markdownFile))
+}
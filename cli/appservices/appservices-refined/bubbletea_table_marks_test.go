@@ -0,0 +1,41 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+func TestSetRowsKeepsMarksOnTheirRows(t *testing.T) {
+	m := New(TableConfig{
+		Columns: []table.Column{{Title: "Name", Width: 10}},
+		Rows:    []table.Row{{"orders"}, {"billing"}, {"search"}, {"billing"}},
+	})
+	m.marked = map[int]bool{1: true, 2: true}
+
+	// Refreshed: reordered, a row added, one of the duplicates gone
+	m.setRows([]table.Row{{"audit"}, {"search"}, {"orders"}, {"billing"}})
+
+	want := []table.Row{{"search"}, {"billing"}}
+	if got := m.markedRows(); !reflect.DeepEqual(got, want) {
+		t.Errorf("marked rows = %v, want %v", got, want)
+	}
+	if len(m.marked) != 2 {
+		t.Errorf("marked = %v, want 2 marks", m.marked)
+	}
+}
+
+func TestSetRowsDropsMarksOnGoneRows(t *testing.T) {
+	m := New(TableConfig{
+		Columns: []table.Column{{Title: "Name", Width: 10}},
+		Rows:    []table.Row{{"orders"}, {"billing"}},
+	})
+	m.marked = map[int]bool{0: true}
+
+	m.setRows([]table.Row{{"billing"}})
+
+	if len(m.marked) != 0 {
+		t.Errorf("marked = %v, want none after the marked row went away", m.marked)
+	}
+}
@@ -0,0 +1,119 @@
+package table
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// detailPanelHeight is how many lines of the detail panel show at a time
+// while scrolling it, when there's no pager to open the details in
+const detailPanelHeight = 10
+
+// pagerDoneMsg comes back to Update once the pager exits
+type pagerDoneMsg struct {
+	err error
+}
+
+// pagerCommand returns $PAGER split into the program and its arguments,
+// falling back to less. nil if neither is available.
+func pagerCommand() []string {
+	if pager := strings.Fields(os.Getenv("PAGER")); len(pager) > 0 {
+		if _, err := exec.LookPath(pager[0]); err == nil {
+			return pager
+		}
+	}
+	if _, err := exec.LookPath("less"); err == nil {
+		return []string{"less"}
+	}
+	return nil
+}
+
+// rowDetailText is the DetailFunc text for a row, or every column as
+// "Title: value" when there is no DetailFunc
+func (m TableModel) rowDetailText(row table.Row) string {
+	if m.detailFunc != nil {
+		return m.detailFunc(row)
+	}
+	var lines []string
+	for _, col := range m.colOrder {
+		if col < len(row) {
+			lines = append(lines, m.allColumns[col].Title+": "+row[col])
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// openPager hands the selected row's details to the pager, the TUI comes back
+// when it exits. Without a pager the detail panel opens in scrolling mode.
+func (m *TableModel) openPager() tea.Cmd {
+	row := m.selectedRow()
+	if row == nil {
+		return nil
+	}
+
+	pager := pagerCommand()
+	if pager == nil {
+		m.showDetails = true
+		m.scrollingDetails = true
+		m.detailOffset = 0
+		return m.setFlash("No $PAGER or less found • ↑/↓ scroll details • esc stop scrolling")
+	}
+
+	cmd := exec.Command(pager[0], pager[1:]...)
+	cmd.Stdin = strings.NewReader(m.rowDetailText(row))
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return pagerDoneMsg{err: err}
+	})
+}
+
+// updateDetailScroll handles keys while scrolling the detail panel and
+// reports whether the key was used
+func (m *TableModel) updateDetailScroll(msg tea.KeyMsg) bool {
+	switch msg.String() {
+	case "up", "k":
+		if m.detailOffset > 0 {
+			m.detailOffset--
+		}
+	case "down", "j":
+		if row := m.selectedRow(); row != nil {
+			lines := strings.Count(m.rowDetailText(row), "\n") + 1
+			if m.detailOffset < lines-detailPanelHeight {
+				m.detailOffset++
+			}
+		}
+	case "esc":
+		m.scrollingDetails = false
+		// Without a DetailFunc the panel was only opened for scrolling
+		if m.detailFunc == nil {
+			m.showDetails = false
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// detailView renders the detail panel content, windowed while scrolling
+func (m TableModel) detailView(row table.Row) string {
+	text := m.rowDetailText(row)
+	if !m.scrollingDetails {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	if len(lines) <= detailPanelHeight {
+		return text
+	}
+	start := m.detailOffset
+	if start > len(lines)-detailPanelHeight {
+		start = len(lines) - detailPanelHeight
+	}
+	end := start + detailPanelHeight
+	window := strings.Join(lines[start:end], "\n")
+	return window + "\n" + fmt.Sprintf("── lines %d-%d of %d ──", start+1, end, len(lines))
+}
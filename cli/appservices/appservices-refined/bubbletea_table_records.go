@@ -0,0 +1,189 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/mattn/go-runewidth"
+	"gopkg.in/yaml.v3"
+)
+
+// Widest a column inferred from records starts out, longer values are truncated
+const maxRecordColumnWidth = 40
+
+// record is one input object, with its keys in the order they appeared
+type record struct {
+	keys   []string
+	values map[string]string
+}
+
+// TableFromJSON builds a table from a JSON array of objects (or a single
+// object), e.g. for `cat data.json | mycli view --tui`. The columns are the
+// union of the objects' keys, in the order they first appear. Strings and
+// numbers are shown as is, booleans as Yes/No, null as an empty cell, and
+// nested objects and arrays as compact JSON.
+func TableFromJSON(r io.Reader) (TableConfig, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return TableConfig{}, fmt.Errorf("failed to read JSON: %w", err)
+	}
+
+	var items []json.RawMessage
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		items = []json.RawMessage{trimmed}
+	} else if err := json.Unmarshal(trimmed, &items); err != nil {
+		return TableConfig{}, fmt.Errorf("expected a JSON array of objects: %w", err)
+	}
+
+	records := make([]record, 0, len(items))
+	for i, item := range items {
+		rec, err := jsonRecord(item)
+		if err != nil {
+			return TableConfig{}, fmt.Errorf("item %d: %w", i, err)
+		}
+		records = append(records, rec)
+	}
+	return recordsToConfig(records), nil
+}
+
+// jsonRecord decodes one object token by token, json.Unmarshal into a map
+// would lose the key order
+func jsonRecord(raw json.RawMessage) (record, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return record{}, fmt.Errorf("expected an object")
+	}
+
+	rec := record{values: map[string]string{}}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return record{}, err
+		}
+		key := tok.(string)
+
+		var value interface{}
+		if err := dec.Decode(&value); err != nil {
+			return record{}, fmt.Errorf("%s: %w", key, err)
+		}
+		if _, seen := rec.values[key]; !seen {
+			rec.keys = append(rec.keys, key)
+		}
+		rec.values[key] = recordCell(value)
+	}
+	return rec, nil
+}
+
+// TableFromYAML is TableFromJSON for a YAML sequence of mappings (or a single mapping)
+func TableFromYAML(r io.Reader) (TableConfig, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if err == io.EOF {
+			return recordsToConfig(nil), nil
+		}
+		return TableConfig{}, fmt.Errorf("failed to read YAML: %w", err)
+	}
+
+	root := &doc
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	items := []*yaml.Node{root}
+	if root.Kind == yaml.SequenceNode {
+		items = root.Content
+	}
+
+	records := make([]record, 0, len(items))
+	for i, item := range items {
+		if item.Kind != yaml.MappingNode {
+			return TableConfig{}, fmt.Errorf("item %d: expected a mapping", i)
+		}
+		rec := record{values: map[string]string{}}
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			key := item.Content[j].Value
+
+			var value interface{}
+			if err := item.Content[j+1].Decode(&value); err != nil {
+				return TableConfig{}, fmt.Errorf("item %d: %s: %w", i, key, err)
+			}
+			if _, seen := rec.values[key]; !seen {
+				rec.keys = append(rec.keys, key)
+			}
+			rec.values[key] = recordCell(value)
+		}
+		records = append(records, rec)
+	}
+	return recordsToConfig(records), nil
+}
+
+// recordCell renders a decoded JSON/YAML value as a table cell
+func recordCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		if v {
+			return "Yes"
+		}
+		return "No"
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+	return fmt.Sprint(value)
+}
+
+// recordsToConfig lays the records out as columns and rows, each column as
+// wide as its widest value up to maxRecordColumnWidth
+func recordsToConfig(records []record) TableConfig {
+	var names []string
+	seen := map[string]bool{}
+	for _, rec := range records {
+		for _, key := range rec.keys {
+			if !seen[key] {
+				seen[key] = true
+				names = append(names, key)
+			}
+		}
+	}
+
+	data := make([]map[string]string, len(records))
+	for i, rec := range records {
+		data[i] = rec.values
+	}
+	rows := DataToRows(data, names)
+
+	columns := make([]table.Column, len(names))
+	for i, name := range names {
+		width := runewidth.StringWidth(name)
+		for _, row := range rows {
+			// Only the first line of a multi-line value shows in a cell
+			first, _, _ := strings.Cut(row[i], "\n")
+			if w := runewidth.StringWidth(first); w > width {
+				width = w
+			}
+		}
+		if width > maxRecordColumnWidth {
+			width = maxRecordColumnWidth
+		}
+		columns[i] = table.Column{Title: name, Width: width + 2}
+	}
+
+	return TableConfig{
+		Title:   fmt.Sprintf("%d records", len(records)),
+		Columns: columns,
+		Rows:    rows,
+	}
+}
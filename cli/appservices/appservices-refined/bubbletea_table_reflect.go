@@ -0,0 +1,195 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// StructsToRows converts a slice of structs (or pointers to structs) to table rows.
+// Each entry in fields names an exported field, either by its Go name or by the
+// name in its `table:"..."` tag. "Field.key" picks one key of a map field with
+// string keys (e.g. "Labels.team"), elements without that key get an empty cell.
+func StructsToRows(data interface{}, fields []string) ([]table.Row, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a slice of structs, got %T", data)
+	}
+
+	rows := make([]table.Row, 0, v.Len())
+	var refs []fieldRef
+	for i := 0; i < v.Len(); i++ {
+		item := reflect.Indirect(v.Index(i))
+		if item.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("expected a slice of structs, got element of type %s", v.Index(i).Type())
+		}
+
+		// Look the fields up once, every element has the same type
+		if refs == nil {
+			var err error
+			refs, err = fieldRefs(item.Type(), fields)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		row := make(table.Row, len(fields))
+		for col, ref := range refs {
+			row[col] = ref.value(item)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// ColumnsFromStruct builds columns from the `table:"Title,width=20"` tags on a struct
+// (or pointer to one, or a slice of them). Fields without a tag are skipped. The
+// title doubles as the field name for StructsToRows, so the same struct drives both.
+func ColumnsFromStruct(v interface{}) ([]table.Column, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %T", v)
+	}
+
+	var columns []table.Column
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("table")
+		if !ok || !f.IsExported() {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		title := parts[0]
+		if title == "" {
+			title = f.Name
+		}
+
+		// Default to fit the title
+		width := len(title) + 2
+		for _, opt := range parts[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+			if key != "width" {
+				continue
+			}
+			w, err := strconv.Atoi(value)
+			if err != nil || w <= 0 {
+				return nil, fmt.Errorf("field %s: width must be a positive integer, got %q", f.Name, value)
+			}
+			width = w
+		}
+
+		columns = append(columns, table.Column{Title: title, Width: width})
+	}
+	return columns, nil
+}
+
+// fieldRef is a requested field: its index in the struct and, for
+// "Field.key", the map key to look up
+type fieldRef struct {
+	index  int
+	key    string
+	hasKey bool
+}
+
+// value renders the referenced field of item as a table cell
+func (r fieldRef) value(item reflect.Value) string {
+	field := item.Field(r.index)
+	if !r.hasKey {
+		return formatValue(field)
+	}
+	if field.IsNil() {
+		return ""
+	}
+	value := field.MapIndex(reflect.ValueOf(r.key).Convert(field.Type().Key()))
+	if !value.IsValid() {
+		return ""
+	}
+	return formatValue(value)
+}
+
+// fieldRefs maps each requested field name to its index in t. "Field.key"
+// needs Field to be a map with string keys; a field (or tag) actually named
+// "Field.key" wins.
+func fieldRefs(t reflect.Type, fields []string) ([]fieldRef, error) {
+	refs := make([]fieldRef, len(fields))
+	for col, name := range fields {
+		ref := fieldRef{index: fieldIndex(t, name)}
+		fieldName := name
+		if i := strings.Index(name, "."); ref.index < 0 && i > 0 {
+			fieldName, ref.key, ref.hasKey = name[:i], name[i+1:], true
+			ref.index = fieldIndex(t, fieldName)
+		}
+		if ref.index < 0 {
+			return nil, fmt.Errorf("%s has no exported field %q", t.Name(), fieldName)
+		}
+		if ft := t.Field(ref.index).Type; ref.hasKey && (ft.Kind() != reflect.Map || ft.Key().Kind() != reflect.String) {
+			return nil, fmt.Errorf("%s.%s is not a map with string keys, can't look up %q", t.Name(), fieldName, ref.key)
+		}
+		refs[col] = ref
+	}
+	return refs, nil
+}
+
+// fieldIndex finds an exported field by its Go or tag name, -1 if there's none
+func fieldIndex(t reflect.Type, name string) int {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.IsExported() && (f.Name == name || tagName(f) == name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// tagName returns the name part of a `table:"Name,..."` tag
+func tagName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("table"), ",")
+	return name
+}
+
+// formatValue renders a field value as a table cell
+func formatValue(v reflect.Value) string {
+	// Follow pointers and interfaces, nil shows as an empty cell
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch val := v.Interface().(type) {
+	case string:
+		return val
+	case time.Time:
+		if val.IsZero() {
+			return ""
+		}
+		return val.Format("2006-01-02 15:04")
+	case fmt.Stringer:
+		return val.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return "Yes"
+		}
+		return "No"
+	case reflect.Slice, reflect.Array:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,161 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// The bubbles table cuts every cell to its column with runewidth, which
+// counts the bytes of ANSI escapes as width, so a styled cell comes out as a
+// cut-off escape sequence. The rows handed to it stay plain text: it keeps
+// the cursor and the key handling, and renderTable draws the rows, styling
+// each cell after it's been cut to its column.
+
+// linePaint is how the cells of a visual row are styled when drawn
+type linePaint struct {
+	status *lipgloss.Style // StatusFunc's style for the status cell
+	stripe bool            // Zebra background across the row
+	search bool            // Highlight the search term in the data cells
+	header bool            // A group header, its text is in the first data cell
+}
+
+// renderTable draws the header and the rows on screen, in place of the
+// bubbles table's View
+func (m TableModel) renderTable() string {
+	return m.headersView() + "\n" + m.bodyView()
+}
+
+// headersView draws the column titles like the bubbles table does
+func (m TableModel) headersView() string {
+	columns := m.table.Columns()
+	cells := make([]string, 0, len(columns))
+	for _, col := range columns {
+		if col.Width <= 0 {
+			continue
+		}
+		cells = append(cells, m.styles.Header.Render(drawCell(col.Title, col.Width, plain, lipgloss.NewStyle())))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
+
+// bodyView draws the visual rows from offset, padded to the table height
+func (m TableModel) bodyView() string {
+	height := m.table.Height()
+	if height <= 0 {
+		return ""
+	}
+	rows := m.table.Rows()
+	columns := m.table.Columns()
+	start := min(m.offset, len(rows))
+	end := min(start+height, len(rows))
+
+	lines := make([]string, 0, end-start)
+	for r := start; r < end; r++ {
+		lines = append(lines, m.renderLine(r, rows[r], columns))
+	}
+	return lipgloss.NewStyle().Height(height).MaxHeight(height).Render(strings.Join(lines, "\n"))
+}
+
+// renderLine draws visual row r, with the selected row's highlight
+func (m TableModel) renderLine(r int, row table.Row, columns []table.Column) string {
+	var paint linePaint
+	if r < len(m.paint) {
+		paint = m.paint[r]
+	}
+	cells := make([]string, 0, len(columns))
+	for i, value := range row {
+		if i >= len(columns) || columns[i].Width <= 0 {
+			continue
+		}
+		cells = append(cells, m.cellStyle(paint).Render(m.drawPaintedCell(paint, i, value, columns[i].Width)))
+	}
+	line := lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+	if r == m.table.Cursor() {
+		return m.styles.Selected.Render(line)
+	}
+	return line
+}
+
+// drawPaintedCell draws cell i of a visual row with the row's paint
+func (m TableModel) drawPaintedCell(paint linePaint, i int, value string, width int) string {
+	base := paint.base()
+	if paint.status != nil && i == m.statusColumn() {
+		return drawCell(value, width, paint.status.Copy().Inherit(base).Render, base)
+	}
+	if paint.header && i == len(m.leadingColumns()) {
+		return drawCell(value, width, groupHeaderStyle.Copy().Inherit(base).Render, base)
+	}
+	if paint.search && i >= len(m.leadingColumns()) {
+		highlight := func(s ...string) string {
+			return highlightTerm(strings.Join(s, " "), m.searchTerm, base)
+		}
+		return drawCell(value, width, highlight, base)
+	}
+	return drawCell(value, width, base.Render, base)
+}
+
+// base is the style every part of the row's cells starts from
+func (p linePaint) base() lipgloss.Style {
+	if p.stripe {
+		return zebraStyle
+	}
+	return lipgloss.NewStyle()
+}
+
+// cellStyle is the table's cell style, with the stripe running through the
+// cell padding so it covers the full row
+func (m TableModel) cellStyle(paint linePaint) lipgloss.Style {
+	if paint.stripe {
+		return m.styles.Cell.Copy().Inherit(zebraStyle)
+	}
+	return m.styles.Cell
+}
+
+// statusColumn is the index of the StatusFunc column in a visual row, -1
+// without one. It comes after the mark column.
+func (m TableModel) statusColumn() int {
+	if m.statusFunc == nil {
+		return -1
+	}
+	if m.multiSelect {
+		return 1
+	}
+	return 0
+}
+
+// drawCell cuts value to width like the bubbles table, styles the text that
+// is left with paint, then pads it to the full width in pad's style
+func drawCell(value string, width int, paint func(...string) string, pad lipgloss.Style) string {
+	text := runewidth.Truncate(value, width, "…")
+	cell := paint(text)
+	if gap := width - runewidth.StringWidth(text); gap > 0 {
+		cell += pad.Render(strings.Repeat(" ", gap))
+	}
+	return cell
+}
+
+// plain is the paint for unstyled text
+func plain(s ...string) string {
+	return strings.Join(s, " ")
+}
+
+// followCursor scrolls the rows so the cursor stays on screen
+func (m *TableModel) followCursor() {
+	height := m.table.Height()
+	cursor := m.table.Cursor()
+	if cursor < m.offset {
+		m.offset = cursor
+	}
+	if height > 0 && cursor >= m.offset+height {
+		m.offset = cursor - height + 1
+	}
+	if last := len(m.table.Rows()) - height; m.offset > last {
+		m.offset = last
+	}
+	if m.offset < 0 {
+		m.offset = 0
+	}
+}
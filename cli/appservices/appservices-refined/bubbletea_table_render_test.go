@@ -0,0 +1,143 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+// inColor renders with 256 colors for the test, the tests otherwise run
+// without a terminal and so without any styling
+func inColor(t *testing.T) {
+	t.Helper()
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+}
+
+// checkView fails if a line of view is cut in the middle of an escape or
+// lacks any of want once the styling is stripped
+func checkView(t *testing.T, view string, want ...string) {
+	t.Helper()
+	if !strings.Contains(view, "\x1b[") {
+		t.Fatal("view has no styling, the color profile wasn't applied")
+	}
+	stripped := ansi.Strip(view)
+	if strings.Contains(stripped, "\x1b") || strings.Contains(stripped, "[38;") || strings.Contains(stripped, "[48;") {
+		t.Errorf("view has a broken escape sequence:\n%q", stripped)
+	}
+	for _, s := range want {
+		if !strings.Contains(stripped, s) {
+			t.Errorf("view lacks %q:\n%s", s, stripped)
+		}
+	}
+}
+
+func statusConfig() TableConfig {
+	return TableConfig{
+		Columns: []table.Column{{Title: "Name", Width: 10}, {Title: "Status", Width: 8}},
+		Rows:    []table.Row{{"orders", "up"}, {"billing", "down"}},
+		StatusFunc: func(row table.Row) (string, lipgloss.Style) {
+			if row[1] == "up" {
+				return "●", lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+			}
+			return "✗", lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+		},
+	}
+}
+
+func TestStatusGlyphInColor(t *testing.T) {
+	inColor(t)
+	m := New(statusConfig())
+	checkView(t, m.View(), "●", "✗", "orders", "billing")
+}
+
+func TestStatusGlyphInColorMultiSelect(t *testing.T) {
+	inColor(t)
+	m := New(statusConfig())
+	m.multiSelect = true
+	m.marked[0] = true
+	m.refreshView()
+	checkView(t, m.View(), "●", "✗", "orders")
+}
+
+func TestFollowCursor(t *testing.T) {
+	rows := make([]table.Row, 40)
+	for i := range rows {
+		rows[i] = table.Row{strings.Repeat("x", i%5+1)}
+	}
+	m := New(TableConfig{Columns: []table.Column{{Title: "Name", Width: 10}}, Rows: rows})
+	height := m.table.Height()
+	if height <= 0 || height >= len(rows) {
+		t.Fatalf("table height %d doesn't need scrolling", height)
+	}
+
+	m.table.SetCursor(len(rows) - 1)
+	m.followCursor()
+	if want := len(rows) - height; m.offset != want {
+		t.Errorf("offset at the last row = %d, want %d", m.offset, want)
+	}
+	m.table.SetCursor(m.offset + 1)
+	m.followCursor()
+	if want := len(rows) - height; m.offset != want {
+		t.Errorf("offset moved to %d while the cursor was on screen, want %d", m.offset, want)
+	}
+	m.table.SetCursor(0)
+	m.followCursor()
+	if m.offset != 0 {
+		t.Errorf("offset at the first row = %d, want 0", m.offset)
+	}
+}
+
+func TestZebraInColor(t *testing.T) {
+	inColor(t)
+	t.Setenv("NO_COLOR", "")
+	m := New(TableConfig{
+		Columns: []table.Column{{Title: "Name", Width: 10}, {Title: "Type", Width: 8}},
+		Rows:    []table.Row{{"orders", "cache"}, {"billing", "db"}, {"search", "queue"}},
+		Zebra:   true,
+	})
+	view := m.View()
+	checkView(t, view, "orders", "billing", "db", "search")
+	if !strings.Contains(view, "48;5;236") {
+		t.Error("the second row isn't striped")
+	}
+}
+
+func TestSearchHighlightInColor(t *testing.T) {
+	inColor(t)
+	m := New(TableConfig{
+		Columns: []table.Column{{Title: "Name", Width: 10}, {Title: "Env", Width: 6}},
+		Rows:    []table.Row{{"orders", "prod"}, {"billing", "test"}, {"search", "prod"}},
+	})
+	m.searchTerm = "prod"
+	m.refreshView()
+	view := m.View()
+	checkView(t, view, "orders", "billing", "test")
+	if got := strings.Count(ansi.Strip(m.renderTable()), "prod"); got != 2 {
+		t.Errorf("table shows %d matches, want 2:\n%s", got, ansi.Strip(view))
+	}
+	if !strings.Contains(view, "48;5;220") {
+		t.Error("the matches aren't highlighted")
+	}
+}
+
+func TestHighlightTerm(t *testing.T) {
+	tests := []struct{ s, term string }{
+		{"prod", "prod"},
+		{"my-prod-cache", "PROD"},
+		{"prodprod", "prod"},
+		{"orders", "prod"},
+		{"", "prod"},
+	}
+	for _, tt := range tests {
+		got := highlightTerm(tt.s, tt.term, lipgloss.NewStyle())
+		if ansi.Strip(got) != tt.s {
+			t.Errorf("highlightTerm(%q, %q) shows %q", tt.s, tt.term, ansi.Strip(got))
+		}
+	}
+}
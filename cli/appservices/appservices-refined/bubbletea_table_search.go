@@ -0,0 +1,158 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Matched text in cells while a search term is set
+var searchMatchStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("0")).
+	Background(lipgloss.Color("220"))
+
+func newSearchInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.CharLimit = 100
+	return ti
+}
+
+// startSearch opens the search input under the table
+func (m *TableModel) startSearch() tea.Cmd {
+	m.searching = true
+	m.searchInput.SetValue(m.searchTerm)
+	m.searchInput.CursorEnd()
+	return m.searchInput.Focus()
+}
+
+// updateSearch handles keys while the search term is being typed. Enter
+// jumps to the first match from the cursor, esc closes the input.
+func (m TableModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.searchInput.Blur()
+		m.searchTerm = strings.TrimSpace(m.searchInput.Value())
+		if m.searchTerm == "" {
+			m.refreshView()
+			return m, nil
+		}
+		return m, m.jumpToMatch(m.searchFrom(), 1)
+	case "esc":
+		m.searching = false
+		m.searchInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	return m, cmd
+}
+
+// clearSearch drops the term and its highlight
+func (m *TableModel) clearSearch() {
+	m.searchTerm = ""
+	m.refreshView()
+}
+
+// searchFrom is where a search starts: the row under the cursor, or the top
+// of the page on an empty one
+func (m TableModel) searchFrom() int {
+	if index := m.selectedIndex(); index >= 0 {
+		return index
+	}
+	return m.pager.Offset()
+}
+
+// rowMatches reports whether any shown cell of the row contains the search term
+func (m TableModel) rowMatches(row table.Row) bool {
+	term := strings.ToLower(m.searchTerm)
+	for _, cell := range m.formattedRow(row) {
+		if strings.Contains(strings.ToLower(cell), term) {
+			return true
+		}
+	}
+	return false
+}
+
+// jumpToMatch moves the cursor to the first matching row at or after from
+// (before it with dir -1), wrapping around and flipping pages as needed
+func (m *TableModel) jumpToMatch(from, dir int) tea.Cmd {
+	total := len(m.allRows)
+	if m.searchTerm == "" || total == 0 {
+		return nil
+	}
+
+	found := -1
+	for i := 0; i < total; i++ {
+		index := ((from+dir*i)%total + total) % total
+		if m.rowMatches(m.allRows[index]) {
+			found = index
+			break
+		}
+	}
+	if found < 0 {
+		m.refreshView()
+		return m.setFlash(fmt.Sprintf("No rows match %q", m.searchTerm))
+	}
+	// "Match 2 of 5"
+	matches, position := 0, 0
+	for i, row := range m.allRows {
+		if m.rowMatches(row) {
+			matches++
+			if i == found {
+				position = matches
+			}
+		}
+	}
+
+	line := m.revealRow(found)
+	m.pager.Goto(line / m.pager.PageSize)
+	m.refreshView()
+	if offset := line % m.pager.PageSize; offset < len(m.rowStarts) {
+		m.table.SetCursor(m.rowStarts[offset])
+		m.followCursor()
+	}
+	return m.setFlash(fmt.Sprintf("Match %d of %d for %q", position, matches, m.searchTerm))
+}
+
+// highlightTerm draws s in base, with each case-insensitive occurrence of
+// term in searchMatchStyle
+func highlightTerm(s, term string, base lipgloss.Style) string {
+	lower := strings.ToLower(s)
+	// Lowercasing changed byte offsets (some non-ASCII letters), don't risk splitting a rune
+	if len(lower) != len(s) || term == "" {
+		return base.Render(s)
+	}
+	needle := strings.ToLower(term)
+	match := searchMatchStyle.Copy().Inherit(base)
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, needle)
+		if i < 0 {
+			if s != "" {
+				b.WriteString(base.Render(s))
+			}
+			return b.String()
+		}
+		if i > 0 {
+			b.WriteString(base.Render(s[:i]))
+		}
+		b.WriteString(match.Render(s[i : i+len(needle)]))
+		s, lower = s[i+len(needle):], lower[i+len(needle):]
+	}
+}
+
+// searchStatus is the line shown above the help while searching or with a term set
+func (m TableModel) searchStatus() string {
+	if m.searching {
+		return m.searchInput.View()
+	}
+	return fmt.Sprintf("/%s • %s: next/previous match • esc: clear", m.searchTerm, helpKeys(m.keys.NextMatch, m.keys.PrevMatch))
+}
@@ -0,0 +1,51 @@
+package table
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// PrintSummary prints the steps of s as a plain table on stderr, so it
+// doesn't mix with --output on stdout. Failed steps show their error.
+func PrintSummary(s *core.Summary) {
+	writeSummary(os.Stderr, s)
+}
+
+func writeSummary(w io.Writer, s *core.Summary) {
+	steps := s.Steps()
+	if len(steps) == 0 {
+		return
+	}
+
+	rows := make([]table.Row, 0, len(steps)+1)
+	for _, step := range steps {
+		duration, detail := "", ""
+		if step.Status != core.StepSkipped {
+			duration = summaryDuration(step.Duration)
+		}
+		if step.Error != nil {
+			detail = step.Error.Error()
+		}
+		rows = append(rows, table.Row{step.Name, step.Status, duration, detail})
+	}
+	rows = append(rows, table.Row{"total", "", summaryDuration(s.Total()), ""})
+
+	fmt.Fprint(w, RenderTablePlain(TableConfig{
+		Columns: []table.Column{{Title: "Step"}, {Title: "Status"}, {Title: "Duration"}, {Title: "Error"}},
+		Rows:    rows,
+	}))
+}
+
+// summaryDuration rounds to what's useful when spotting a slow step
+func summaryDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
@@ -0,0 +1,242 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+	"golang.org/x/term"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// ErrNoTUI is returned when the terminal can't run the interactive table and
+// the plain fallback is off (--no-tui-fallback)
+var ErrNoTUI = errors.New("the interactive table needs a terminal (stdout is not a TTY)")
+
+// ErrInterrupted is returned (wrapped) when the process gets SIGINT or SIGTERM
+// while a table is running, after the terminal has been restored
+var ErrInterrupted = core.ErrInterrupted
+
+// tuiFallback prints a plain table when the interactive one can't run
+var tuiFallback = true
+
+// SetTUIFallback turns the plain printed fallback on or off. With it off,
+// ShowTable returns ErrNoTUI instead, for --no-tui-fallback.
+func SetTUIFallback(on bool) {
+	tuiFallback = on
+}
+
+// terminalCaps is what the terminal the table would run in supports
+type terminalCaps struct {
+	tty   bool // stdout is an interactive terminal
+	mouse bool // the terminal reports mouse events
+}
+
+// detectTerminal checks stdout and the environment. CI and TERM=dumb count as
+// no TTY even when one is attached, and tmux only gets mouse events when its
+// mouse option is on. With a ProgramRunner set there's no terminal to check,
+// the interactive table always runs.
+func detectTerminal() terminalCaps {
+	if runner != nil {
+		return terminalCaps{tty: true}
+	}
+	termEnv := os.Getenv("TERM")
+	if !term.IsTerminal(int(os.Stdout.Fd())) || termEnv == "" || termEnv == "dumb" || os.Getenv("CI") != "" {
+		return terminalCaps{}
+	}
+
+	caps := terminalCaps{tty: true, mouse: true}
+	if os.Getenv("TMUX") != "" {
+		out, err := exec.Command("tmux", "show-options", "-gv", "mouse").Output()
+		caps.mouse = err == nil && strings.TrimSpace(string(out)) == "on"
+	}
+	return caps
+}
+
+// programOptions uses the alt screen only on a real terminal, and mouse
+// (wheel scrolling) only when asked for and the terminal supports it
+func (c terminalCaps) programOptions(mouse bool) []tea.ProgramOption {
+	var opts []tea.ProgramOption
+	if c.tty {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	if c.tty && c.mouse && mouse {
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	return opts
+}
+
+// ProgramRunner runs a table's model until it quits and returns the final model
+type ProgramRunner func(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error)
+
+// runner replaces the terminal when set, see SetProgramRunner
+var runner ProgramRunner
+
+// SetProgramRunner makes ShowTable, RunTable and the other table functions
+// run through r instead of the terminal, and returns a func that puts back
+// the previous runner. It's the seam for tests: drive the model with scripted
+// keys (e.g. teatest) and check what the function returns.
+func SetProgramRunner(r ProgramRunner) (restore func()) {
+	previous := runner
+	runner = r
+	return func() { runner = previous }
+}
+
+// runProgram runs a table program. SIGINT/SIGTERM quit it through bubbletea's
+// normal shutdown, which leaves the alt screen and raw mode, and make it
+// return ErrInterrupted.
+func runProgram(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	if runner != nil {
+		return runner(model, opts...)
+	}
+	p := tea.NewProgram(model, append(opts, tea.WithoutSignalHandler())...)
+	interrupted, release := core.CatchInterrupt(p.Quit)
+	finalModel, err := p.Run()
+	release()
+	if interrupted() {
+		return finalModel, ErrInterrupted
+	}
+	return finalModel, err
+}
+
+// printPlainTable is the fallback when there's no terminal: RenderTablePlain,
+// with lazily fetched pages loaded up front
+func printPlainTable(w io.Writer, config TableConfig) error {
+	rows, err := collectAllRows(config)
+	if err != nil {
+		return err
+	}
+	config.Rows = rows
+	config.FetchPage = nil
+
+	_, err = io.WriteString(w, RenderTablePlain(config))
+	return err
+}
+
+// RenderTablePlain renders every row of the table as static text, without
+// bubbletea: a bordered table, or tab-separated values with config.PlainTabs.
+// Columns are as wide as their widest value, nothing is truncated or styled.
+// Hidden columns are left out and Formatters are applied.
+func RenderTablePlain(config TableConfig) string {
+	order := visibleOrder(config.Columns, config.ColumnOptions)
+	titles := make([]string, len(order))
+	for i, col := range order {
+		titles[i] = config.Columns[col].Title
+	}
+	rows := make([]table.Row, len(config.Rows))
+	for i, row := range config.Rows {
+		rows[i] = make(table.Row, len(order))
+		for j, col := range order {
+			if col < len(row) {
+				rows[i][j] = row[col]
+			}
+			if format := config.Formatters[col]; format != nil {
+				rows[i][j] = format(rows[i][j])
+			}
+		}
+	}
+
+	var s strings.Builder
+	if config.PlainTabs {
+		s.WriteString(strings.Join(titles, "\t") + "\n")
+		for _, row := range rows {
+			s.WriteString(strings.Join(plainCells(row, len(titles)), "\t") + "\n")
+		}
+		return s.String()
+	}
+
+	widths := make([]int, len(titles))
+	for i, title := range titles {
+		widths[i] = runewidth.StringWidth(title)
+	}
+	for _, row := range rows {
+		for i, cell := range plainCells(row, len(titles)) {
+			if w := runewidth.StringWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	border := func(left, mid, right string) string {
+		parts := make([]string, len(widths))
+		for i, w := range widths {
+			parts[i] = strings.Repeat("─", w+2)
+		}
+		return left + strings.Join(parts, mid) + right + "\n"
+	}
+	line := func(cells []string) string {
+		parts := make([]string, len(widths))
+		for i, w := range widths {
+			parts[i] = " " + runewidth.FillRight(cells[i], w) + " "
+		}
+		return "│" + strings.Join(parts, "│") + "│\n"
+	}
+
+	if config.Title != "" {
+		s.WriteString(config.Title + "\n")
+	}
+	s.WriteString(border("┌", "┬", "┐"))
+	s.WriteString(line(titles))
+	s.WriteString(border("├", "┼", "┤"))
+	for _, row := range rows {
+		s.WriteString(line(plainCells(row, len(titles))))
+	}
+	s.WriteString(border("└", "┴", "┘"))
+	fmt.Fprintf(&s, "%d rows\n", len(rows))
+	return s.String()
+}
+
+// plainCells pads or cuts a row to n cells, so short rows still line up
+func plainCells(row table.Row, n int) []string {
+	cells := make([]string, n)
+	copy(cells, row)
+	return cells
+}
+
+// collectAllRows returns config.Rows plus, with FetchPage, every remaining page
+func collectAllRows(config TableConfig) ([]table.Row, error) {
+	rows := config.Rows
+	if config.FetchPage == nil {
+		return rows, nil
+	}
+	for page := 0; ; page++ {
+		pageRows, more, err := config.FetchPage(page)
+		if err != nil {
+			return rows, err
+		}
+		rows = append(rows, pageRows...)
+		if !more || len(pageRows) == 0 {
+			return rows, nil
+		}
+	}
+}
+
+// noTUI prints the plain fallback for config, or returns ErrNoTUI if it's off
+func noTUI(config TableConfig) error {
+	if !tuiFallback {
+		return ErrNoTUI
+	}
+	return printPlainTable(os.Stdout, config)
+}
+
+// startFailed handles the interactive table failing to run (no usable TTY
+// after all, input couldn't be opened): it warns and prints the plain table
+// instead, so the data still shows. Only a failing plain render is an error.
+// Interrupts and a disabled fallback return err as is.
+func startFailed(config TableConfig, err error) error {
+	if errors.Is(err, ErrInterrupted) || !tuiFallback {
+		return fmt.Errorf("error running table: %w", err)
+	}
+	core.WarnMsg(fmt.Sprintf("Couldn't start the interactive table (%v), printing it instead", err))
+	if plainErr := printPlainTable(os.Stdout, config); plainErr != nil {
+		return fmt.Errorf("error running table: %w (plain fallback also failed: %v)", err, plainErr)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// minColumnWidth is the narrowest a column is drawn, room for "ab…"
+const minColumnWidth = 3
+
+// checkColumnWidths fixes up the configured column widths before the table
+// is built: widths below minColumnWidth (0 from CreateColumns, negative from
+// arithmetic in the caller) are raised to it. Columns that don't fit the
+// terminal keep their widths, the table scrolls sideways to them (or fits
+// them by Priority, see fitColumns). Returns a copy, the caller's columns
+// are never changed.
+func checkColumnWidths(columns []table.Column) []table.Column {
+	checked := append([]table.Column(nil), columns...)
+	for i := range checked {
+		if checked[i].Width < minColumnWidth {
+			checked[i].Width = minColumnWidth
+		}
+	}
+	return checked
+}
@@ -0,0 +1,65 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// widths returns the width of each column
+func widths(columns []table.Column) []int {
+	w := make([]int, len(columns))
+	for i, col := range columns {
+		w[i] = col.Width
+	}
+	return w
+}
+
+func TestCheckColumnWidths(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []table.Column
+		want    []int
+	}{
+		{"fits", []table.Column{{Width: 20}, {Width: 10}}, []int{20, 10}},
+		{"zero and negative raised", []table.Column{{Width: 0}, {Width: -4}}, []int{minColumnWidth, minColumnWidth}},
+		{"oversized kept", []table.Column{{Width: 200}, {Width: 150}}, []int{200, 150}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns := append([]table.Column(nil), tt.columns...)
+			if got := widths(checkColumnWidths(columns)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("widths = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(columns, tt.columns) {
+				t.Errorf("caller's columns were changed: %v", widths(columns))
+			}
+		})
+	}
+}
+
+func TestOversizedColumnsScroll(t *testing.T) {
+	m := New(TableConfig{
+		Columns: []table.Column{{Title: "Name", Width: 60}, {Title: "Type", Width: 60}, {Title: "Status", Width: 60}},
+		Rows:    []table.Row{{"orders", "cache", "up"}},
+		Width:   80,
+	})
+	if got := widths(m.allColumns); !reflect.DeepEqual(got, []int{60, 60, 60}) {
+		t.Errorf("columns narrowed to %v, want them kept", got)
+	}
+	if m.flash != "" {
+		t.Errorf("flash = %q, want none", m.flash)
+	}
+	if !m.hasColumnsRight() {
+		t.Error("columns off the right edge can't be scrolled to")
+	}
+
+	// A wide enough terminal shows them all at their own widths
+	model, _ := m.Update(tea.WindowSizeMsg{Width: 220, Height: 40})
+	m = model.(TableModel)
+	if m.visibleCols != 3 || m.hasColumnsRight() {
+		t.Errorf("%d columns shown on a 220 wide terminal, want all 3", m.visibleCols)
+	}
+}
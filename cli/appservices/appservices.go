@@ -35,6 +35,9 @@ func Cmd() *cobra.Command {
 	// ADD THIS NEW FLAG
 	cmd.Flags().BoolVar(&flags.tui, "tui", false, "Show interactive table UI")
 	
+	// Single app service lookup
+	cmd.AddCommand(DescribeCmd())
+	
 	return cmd
 }
 
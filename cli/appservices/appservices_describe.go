@@ -0,0 +1,62 @@
+// appservices_describe.go - Put this in cmd/merna/get/appservices/ folder
+package appservices
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/merna"
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/output"
+)
+
+type describeFlags struct {
+	output    output.Flags
+	id        string
+	serviceID string
+	tui       bool
+}
+
+// DescribeCmd is 'app-services describe', which shows a single app service
+func DescribeCmd() *cobra.Command {
+	flags := &describeFlags{}
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Gets a single app service of a business app",
+		Run: func(_ *cobra.Command, _ []string) {
+			describe(flags)
+		},
+	}
+
+	flags.output.Bind(cmd, output.TypeJSON, output.TypeYaml)
+	flags.output.SetDefaultFormat(output.TypeYaml)
+	flags.output.QueryString = "."
+
+	cmd.Flags().StringVarP(&flags.id, "id", "i", "", "The SOLID ID of the business application")
+	cmd.Flags().StringVarP(&flags.serviceID, "service-id", "s", "", "The ID of the app service")
+	cmd.Flags().BoolVar(&flags.tui, "tui", false, "Show the app service in a detail view")
+	cmd.MarkFlagRequired("service-id")
+
+	return cmd
+}
+
+func describe(flags *describeFlags) {
+	id, err := merna.PromptSoleID(flags.id)
+	core.ExitIfError(err)
+
+	service, err := merna.GetAppService(id, flags.serviceID)
+	if errors.Is(err, merna.ErrAppServiceNotFound) {
+		// Not a failure of the CLI or the API, so use a distinct exit code
+		core.ExitIfErrorCode(err, 2)
+	}
+	core.ExitIfError(err)
+
+	if flags.tui {
+		fmt.Println(appServiceTableModel{}.renderDetails(service))
+		return
+	}
+
+	flags.output.Print(service)
+}
@@ -61,6 +61,7 @@ type appServiceTableModel struct {
 	width        int
 	height       int
 	showDetails  bool
+	showHelp     bool
 }
 
 // Initialize the table model with app services data
@@ -136,7 +137,8 @@ func (m appServiceTableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter", " ":
 			m.showDetails = !m.showDetails
 		case "?":
-			// Toggle help
+			m.showHelp = !m.showHelp
+			return m, nil
 		}
 	}
 	
@@ -162,6 +164,11 @@ func (m appServiceTableModel) View() string {
 		s.WriteString(details + "\n")
 	}
 	
+	// Full key list, toggled with ?
+	if m.showHelp {
+		s.WriteString(m.renderHelp() + "\n")
+	}
+	
 	// Help
 	help := helpStyle.Render("↑↓ navigate • ↵ toggle details • q quit • ? help")
 	s.WriteString(help)
@@ -201,6 +208,28 @@ func (m appServiceTableModel) renderDetails(service merna.ApplicationServices) s
 	return infoStyle.Render(details.String())
 }
 
+// Keys handled by Update - keep in sync when adding keys
+var appServiceTableKeys = [][2]string{
+	{"↑/k", "move up"},
+	{"↓/j", "move down"},
+	{"enter/space", "toggle details"},
+	{"?", "toggle this help"},
+	{"q/esc", "quit"},
+}
+
+func (m appServiceTableModel) renderHelp() string {
+	var help strings.Builder
+	
+	help.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("86")).Render("⌨️  Key Bindings") + "\n\n")
+	for _, k := range appServiceTableKeys {
+		help.WriteString(fmt.Sprintf("  %s %s\n", 
+			lipgloss.NewStyle().Bold(true).Width(12).Render(k[0]), 
+			k[1]))
+	}
+	
+	return infoStyle.Render(help.String())
+}
+
 // Helper function to determine service type
 func getServiceType(service merna.ApplicationServices) string {
 	// Implement your logic here based on how you determine the type
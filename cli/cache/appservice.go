@@ -0,0 +1,40 @@
+package merna
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrAppServiceNotFound is returned by GetAppService when the business app
+// has no app service with the given ID
+var ErrAppServiceNotFound = errors.New("app service not found")
+
+// GetAppService fetches a single app service of a business app. It pages
+// through GetAppServices and stops at the first page containing serviceID.
+// Transport and API errors are returned as-is, a missing service wraps
+// ErrAppServiceNotFound.
+func GetAppService(appID, serviceID string) (ApplicationServices, error) {
+	var cursor *string
+	for {
+		resp, err := GetAppServices(appID, cursor)
+		if err != nil {
+			return ApplicationServices{}, err
+		}
+		if HasFatal(HandleErrors(resp.Errors)) {
+			return ApplicationServices{}, errors.New(strings.Join(HandleErrorStrings(resp.Errors), "\n"))
+		}
+
+		page := resp.Data.PaginatedApplicationServices
+		for _, service := range page.Results {
+			if fmt.Sprint(service.ID) == serviceID {
+				return service, nil
+			}
+		}
+
+		if !page.HasNext {
+			return ApplicationServices{}, fmt.Errorf("%w: %s in business app %s", ErrAppServiceNotFound, serviceID, appID)
+		}
+		cursor = &page.Cursor
+	}
+}
@@ -0,0 +1,145 @@
+package merna
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrPartialResults is wrapped by fetch functions that return some, but not
+// all, app services. Those results are passed through but never cached.
+var ErrPartialResults = errors.New("partial results")
+
+// DefaultCacheTTL is how long cached app services are used before re-fetching
+const DefaultCacheTTL = 15 * time.Minute
+
+// CacheOptions controls GetAppServicesCached.
+//
+// Cache invalidation: an entry is re-fetched once it is older than TTL.
+// Refresh ignores the cached entry and overwrites it with a fresh fetch
+// (--refresh), NoCache neither reads nor writes the cache (--no-cache).
+// Entries can also be dropped with InvalidateAppServicesCache or by deleting
+// the files under CacheDir.
+type CacheOptions struct {
+	TTL     time.Duration // 0 uses DefaultCacheTTL
+	Refresh bool
+	NoCache bool
+}
+
+// CacheDir returns the directory cached app services are stored in.
+// Swap it out to point the cache at a temp dir.
+var CacheDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "statefarm", "app-services"), nil
+}
+
+// appServicesCacheEntry is the JSON written to disk for one id + env
+type appServicesCacheEntry struct {
+	ID        string                `json:"id"`
+	Env       string                `json:"env"`
+	FetchedAt time.Time             `json:"fetchedAt"`
+	Services  []ApplicationServices `json:"services"`
+}
+
+// GetAppServicesCached returns the cached app services for id + env, calling
+// fetch for fresh ones only when the cache is missing or stale
+func GetAppServicesCached(id, env string, opts CacheOptions, fetch func() ([]ApplicationServices, error)) ([]ApplicationServices, error) {
+	if opts.NoCache {
+		return fetch()
+	}
+
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	if !opts.Refresh {
+		if entry, err := readAppServicesCache(id, env); err == nil && time.Since(entry.FetchedAt) < ttl {
+			return entry.Services, nil
+		}
+	}
+
+	services, err := fetch()
+	if errors.Is(err, ErrPartialResults) {
+		return services, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// A cache that can't be written just means the next run fetches again
+	_ = writeAppServicesCache(id, env, services)
+	return services, nil
+}
+
+// InvalidateAppServicesCache removes the cached entry for id + env
+func InvalidateAppServicesCache(id, env string) error {
+	path, err := appServicesCachePath(id, env)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove cache %s: %w", path, err)
+	}
+	return nil
+}
+
+func readAppServicesCache(id, env string) (*appServicesCacheEntry, error) {
+	path, err := appServicesCachePath(id, env)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry appServicesCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func writeAppServicesCache(id, env string, services []ApplicationServices) error {
+	path, err := appServicesCachePath(id, env)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(appServicesCacheEntry{
+		ID:        id,
+		Env:       env,
+		FetchedAt: time.Now(),
+		Services:  services,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temp file first so a concurrent run never reads half a file
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// appServicesCachePath hashes the key so any id/env is a safe file name
+func appServicesCachePath(id, env string) (string, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(id + "\x00" + env))
+	return filepath.Join(dir, hex.EncodeToString(sum[:8])+".json"), nil
+}
@@ -0,0 +1,121 @@
+package merna
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+// tempCacheDir points CacheDir at a fresh temp dir for the test
+func tempCacheDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	previous := CacheDir
+	CacheDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { CacheDir = previous })
+	return dir
+}
+
+// countingFetch returns services and counts how often it was called
+func countingFetch(services []ApplicationServices, calls *int) func() ([]ApplicationServices, error) {
+	return func() ([]ApplicationServices, error) {
+		*calls++
+		return services, nil
+	}
+}
+
+func TestAppServicesCacheRoundTrip(t *testing.T) {
+	dir := tempCacheDir(t)
+	calls := 0
+	fetch := countingFetch([]ApplicationServices{{Name: "orders"}, {Name: "billing"}}, &calls)
+
+	first, err := GetAppServicesCached("app1", "test", CacheOptions{}, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := GetAppServicesCached("app1", "test", CacheOptions{}, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1 (second read from the cache)", calls)
+	}
+	if len(second) != 2 || second[0].Name != first[0].Name || second[1].Name != first[1].Name {
+		t.Errorf("cached services = %+v, want %+v", second, first)
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 1 {
+		t.Errorf("cache dir has %d files, want 1", len(files))
+	}
+
+	// Another env is another entry
+	if _, err := GetAppServicesCached("app1", "prod", CacheOptions{}, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2 (prod isn't cached yet)", calls)
+	}
+}
+
+func TestAppServicesCacheTTL(t *testing.T) {
+	tempCacheDir(t)
+	calls := 0
+	fetch := countingFetch([]ApplicationServices{{Name: "orders"}}, &calls)
+	opts := CacheOptions{TTL: time.Millisecond}
+
+	if _, err := GetAppServicesCached("app1", "", opts, fetch); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := GetAppServicesCached("app1", "", opts, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2 (the entry expired)", calls)
+	}
+}
+
+func TestAppServicesCacheRefresh(t *testing.T) {
+	tempCacheDir(t)
+	calls := 0
+	fetch := countingFetch([]ApplicationServices{{Name: "orders"}}, &calls)
+
+	if _, err := GetAppServicesCached("app1", "", CacheOptions{}, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetAppServicesCached("app1", "", CacheOptions{Refresh: true}, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2 (Refresh skips the cache)", calls)
+	}
+
+	// Refresh overwrote the entry, so a normal read uses it again
+	if _, err := GetAppServicesCached("app1", "", CacheOptions{}, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2 (the refreshed entry is cached)", calls)
+	}
+}
+
+func TestAppServicesCacheSkipsPartialAndNoCache(t *testing.T) {
+	dir := tempCacheDir(t)
+	partial := func() ([]ApplicationServices, error) {
+		return []ApplicationServices{{Name: "orders"}}, ErrPartialResults
+	}
+	services, err := GetAppServicesCached("app1", "", CacheOptions{}, partial)
+	if !errors.Is(err, ErrPartialResults) || len(services) != 1 {
+		t.Fatalf("GetAppServicesCached() = %v, %v, want the partial results", services, err)
+	}
+
+	calls := 0
+	fetch := countingFetch([]ApplicationServices{{Name: "orders"}}, &calls)
+	if _, err := GetAppServicesCached("app1", "", CacheOptions{NoCache: true}, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("cache dir has %d files, want none (partial results and NoCache aren't written)", len(files))
+	}
+}
@@ -0,0 +1,149 @@
+package merna
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// errorListModel lists API errors one line each, Enter expands an error to
+// show its full message, code and severity
+type errorListModel struct {
+	errs     []APIError
+	cursor   int
+	expanded map[int]bool
+	done     bool
+	width    int // Terminal width, for the help line
+}
+
+func newErrorListModel(errs []APIError) errorListModel {
+	return errorListModel{
+		errs:     errs,
+		expanded: make(map[int]bool),
+	}
+}
+
+func (m errorListModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m errorListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, promptKeys.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, promptKeys.Down):
+			if m.cursor < len(m.errs)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, promptKeys.Confirm, promptKeys.Toggle):
+			m.expanded[m.cursor] = !m.expanded[m.cursor]
+		case isListCancel(msg):
+			m.done = true
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m errorListModel) View() string {
+	if m.done {
+		return ""
+	}
+
+	icons := core.CurrentIcons()
+	var s strings.Builder
+	s.WriteString(promptStyle.Render(fmt.Sprintf("%s %d error(s) from the API", icons.Error, len(m.errs))) + "\n")
+
+	var list strings.Builder
+	for i, e := range m.errs {
+		cursor := "  "
+		summary := firstLine(e.Message)
+		if m.cursor == i {
+			cursor = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Render(icons.Cursor + " ")
+			summary = selectedStyle.Render(summary)
+		}
+
+		marker := icons.Collapsed
+		if m.expanded[i] {
+			marker = icons.Expanded
+		}
+		list.WriteString(fmt.Sprintf("%s%s %s %s", cursor, marker, severityGlyph(e.Severity), summary))
+
+		if m.expanded[i] {
+			list.WriteString("\n" + errorDetails(e))
+		}
+		if i < len(m.errs)-1 {
+			list.WriteString("\n")
+		}
+	}
+	s.WriteString(core.Box(list.String(), activeContainerBox) + "\n")
+
+	up, down, confirm := keyLabel(promptKeys.Up), keyLabel(promptKeys.Down), keyLabel(promptKeys.Confirm)
+	s.WriteString(helpLine(m.width,
+		fmt.Sprintf("%s%s navigate • %s expand/collapse • q close", up, down, confirm),
+		fmt.Sprintf("%s%s %s q", up, down, confirm)) + "\n")
+	return s.String()
+}
+
+// severityGlyph marks fatal errors red and warnings orange
+func severityGlyph(severity Severity) string {
+	if severity == SeverityWarning {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(core.CurrentIcons().Warning)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render(core.CurrentIcons().Error)
+}
+
+// errorDetails renders the expanded part of an error, indented under its summary
+func errorDetails(e APIError) string {
+	detail := lipgloss.NewStyle().Foreground(lipgloss.Color("250")).PaddingLeft(6)
+	code := e.Code
+	if code == "" {
+		code = "-"
+	}
+
+	lines := []string{
+		"Code:     " + code,
+		"Severity: " + string(e.Severity),
+	}
+	for _, line := range strings.Split(e.Message, "\n") {
+		lines = append(lines, "  "+line)
+	}
+	return detail.Render(strings.Join(lines, "\n"))
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + " …"
+	}
+	return s
+}
+
+// ShowErrors reports API errors. With tui it opens a navigable list where
+// each error can be expanded, otherwise the messages are printed together.
+func ShowErrors(errs []APIError, tui bool) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	if !tui {
+		messages := make([]string, len(errs))
+		for i, e := range errs {
+			messages[i] = e.Error()
+		}
+		core.ErrorMsg(strings.Join(messages, "\n"))
+		return nil
+	}
+
+	_, err := runProgram(newErrorListModel(errs), programOptions()...)
+	return err
+}
@@ -0,0 +1,74 @@
+package merna
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity says whether an API error should stop the command
+type Severity string
+
+const (
+	SeverityFatal   Severity = "fatal"
+	SeverityWarning Severity = "warning"
+)
+
+// ResponseError is a single entry in a GraphQL response's errors list
+type ResponseError struct {
+	Message    string                 `json:"message"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// APIError is a classified error from the merna API
+type APIError struct {
+	Code     string
+	Message  string
+	Severity Severity
+}
+
+func (e APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+	}
+	return e.Message
+}
+
+// HandleErrors classifies the errors from a response. Errors are fatal unless
+// the API marks them otherwise with extensions.severity = "WARNING".
+func HandleErrors(errs []ResponseError) []APIError {
+	apiErrors := make([]APIError, 0, len(errs))
+	for _, e := range errs {
+		apiErr := APIError{
+			Message:  e.Message,
+			Severity: SeverityFatal,
+		}
+		if code, ok := e.Extensions["code"].(string); ok {
+			apiErr.Code = code
+		}
+		if severity, ok := e.Extensions["severity"].(string); ok && strings.EqualFold(severity, "warning") {
+			apiErr.Severity = SeverityWarning
+		}
+		apiErrors = append(apiErrors, apiErr)
+	}
+	return apiErrors
+}
+
+// HasFatal reports whether any of the errors should stop the command
+func HasFatal(errs []APIError) bool {
+	for _, e := range errs {
+		if e.Severity == SeverityFatal {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleErrorStrings is the old HandleErrors, returning just the messages
+func HandleErrorStrings(errs []ResponseError) []string {
+	apiErrors := HandleErrors(errs)
+	messages := make([]string, len(apiErrors))
+	for i, e := range apiErrors {
+		messages[i] = e.Error()
+	}
+	return messages
+}
@@ -0,0 +1,257 @@
+package merna
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// FormField is one field of a PromptForm. A field with Choices is a select
+// (←/→ to change), otherwise it's a text input.
+type FormField struct {
+	Key      string
+	Label    string
+	Default  string
+	Choices  []string
+	Validate func(string) error // Optional, runs on submit
+}
+
+// formField is a FormField with its input state
+type formField struct {
+	FormField
+	input  textinput.Model
+	choice int
+	err    error
+}
+
+func (f formField) isSelect() bool {
+	return len(f.Choices) > 0
+}
+
+func (f formField) value() string {
+	if f.isSelect() {
+		return f.Choices[f.choice]
+	}
+	return f.input.Value()
+}
+
+// formModel shows several fields on one screen. Tab/shift+tab move focus,
+// enter validates every field and submits them together.
+type formModel struct {
+	label  string
+	fields []formField
+	focus  int
+	done   bool
+	submit bool
+	width  int // Terminal width, for the help line
+}
+
+func newFormModel(label string, fields []FormField) formModel {
+	m := formModel{label: label}
+	for _, f := range fields {
+		field := formField{FormField: f}
+		if field.isSelect() {
+			for i, c := range f.Choices {
+				if strings.EqualFold(c, f.Default) {
+					field.choice = i
+				}
+			}
+		} else {
+			field.input = textinput.New()
+			field.input.SetValue(f.Default)
+			field.input.CharLimit = 156
+			field.input.Width = 40
+		}
+		m.fields = append(m.fields, field)
+	}
+	m.setFocus(0)
+	return m
+}
+
+// setFocus moves focus to field i, only the focused text input shows a cursor
+func (m *formModel) setFocus(i int) {
+	for j := range m.fields {
+		if m.fields[j].isSelect() {
+			continue
+		}
+		if j == i {
+			m.fields[j].input.Focus()
+		} else {
+			m.fields[j].input.Blur()
+		}
+	}
+	m.focus = i
+}
+
+// validate runs every field's validator and focuses the first one that fails
+func (m *formModel) validate() bool {
+	first := -1
+	for i := range m.fields {
+		f := &m.fields[i]
+		f.err = nil
+		if f.Validate != nil {
+			f.err = f.Validate(f.value())
+		}
+		if f.err != nil && first < 0 {
+			first = i
+		}
+	}
+	if first >= 0 {
+		m.setFocus(first)
+		return false
+	}
+	return true
+}
+
+func (m formModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m formModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = msg.Width
+		return m, nil
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		field := &m.fields[m.focus]
+		// Letters bound to up/down (j/k) still type into a text field
+		navigates := field.isSelect() || msg.Type != tea.KeyRunes
+		switch {
+		case msg.String() == "tab", navigates && key.Matches(msg, promptKeys.Down):
+			m.setFocus((m.focus + 1) % len(m.fields))
+			return m, nil
+		case msg.String() == "shift+tab", navigates && key.Matches(msg, promptKeys.Up):
+			m.setFocus((m.focus - 1 + len(m.fields)) % len(m.fields))
+			return m, nil
+		case key.Matches(msg, promptKeys.Confirm):
+			if !m.validate() {
+				return m, nil
+			}
+			m.submit = true
+			m.done = true
+			return m, tea.Quit
+		case isCancel(msg):
+			m.done = true
+			return m, tea.Quit
+		}
+		switch msg.String() {
+		case "left", "h":
+			if field.isSelect() {
+				field.choice = (field.choice - 1 + len(field.Choices)) % len(field.Choices)
+				field.err = nil
+				return m, nil
+			}
+		case "right", "l", " ":
+			if field.isSelect() {
+				field.choice = (field.choice + 1) % len(field.Choices)
+				field.err = nil
+				return m, nil
+			}
+		}
+	}
+
+	// Everything else goes to the focused text input
+	field := &m.fields[m.focus]
+	if field.isSelect() {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	field.input, cmd = field.input.Update(cleanPaste(msg))
+	if _, ok := msg.(tea.KeyMsg); ok {
+		// Clear the field's error when the user types
+		field.err = nil
+	}
+	return m, cmd
+}
+
+func (m formModel) View() string {
+	if m.done {
+		return ""
+	}
+
+	labelWidth := 0
+	for _, f := range m.fields {
+		if w := lipgloss.Width(f.Label); w > labelWidth {
+			labelWidth = w
+		}
+	}
+	labelStyle := lipgloss.NewStyle().Width(labelWidth + 2)
+
+	icons := core.CurrentIcons()
+	var s strings.Builder
+	s.WriteString(stepLine())
+	s.WriteString(promptStyle.Render(icons.Form+" "+m.label) + "\n")
+
+	var body strings.Builder
+	for i, f := range m.fields {
+		cursor := "  "
+		label := labelStyle.Render(f.Label)
+		if i == m.focus {
+			cursor = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Render(icons.Cursor + " ")
+			label = selectedStyle.Copy().Width(labelWidth + 2).Render(f.Label)
+		}
+
+		var input string
+		if f.isSelect() {
+			input = "◀ " + f.Choices[f.choice] + " ▶"
+			if i == m.focus {
+				input = selectedStyle.Render(input)
+			}
+		} else {
+			input = f.input.View()
+		}
+		body.WriteString(cursor + label + input)
+
+		if f.err != nil {
+			body.WriteString("\n" + errorStyle.Render(fmt.Sprintf("%*s%s %s", labelWidth+2, "", icons.Error, f.err.Error())))
+		}
+		if i < len(m.fields)-1 {
+			body.WriteString("\n")
+		}
+	}
+
+	box := activeContainerBox
+	for _, f := range m.fields {
+		if f.err != nil {
+			box = errorContainerBox
+			break
+		}
+	}
+	s.WriteString(core.Box(body.String(), box) + "\n")
+
+	confirm, cancel := keyLabel(promptKeys.Confirm), keyLabel(promptKeys.Cancel)
+	s.WriteString(helpLine(m.width,
+		fmt.Sprintf("tab/shift+tab move • ←→ change choice • %s submit • %s cancel", confirm, cancel),
+		fmt.Sprintf("tab ←→ %s %s", confirm, cancel)) + "\n")
+	return s.String()
+}
+
+// PromptForm asks for all the fields on one screen and returns the values by
+// FormField.Key. Use it instead of a Wizard for a few related inputs.
+func PromptForm(label string, fields []FormField) (map[string]string, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("form has no fields")
+	}
+
+	finalModel, err := runProgram(newFormModel(label, fields), programOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	m := finalModel.(formModel)
+	if !m.submit {
+		return nil, ErrCancelled
+	}
+
+	values := make(map[string]string, len(m.fields))
+	for _, f := range m.fields {
+		values[f.Key] = f.value()
+	}
+	return values, nil
+}
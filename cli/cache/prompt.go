@@ -1,147 +1,456 @@
 package merna
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
 )
 
-// Styles using lipgloss
-var (
-	promptStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
-		Bold(true)
+// ErrCancelled is returned by every prompt when the user backs out (esc/ctrl+c).
+// The ...Result prompts return cancelled == true instead, so backing out, an
+// empty answer and a real error are three separate cases.
+var ErrCancelled = errors.New("cancelled")
+
+// ErrBack is returned instead when the user asks for the previous wizard step
+var ErrBack = errors.New("back")
+
+// ErrTooManyAttempts is returned (wrapped, with the last validation error)
+// when a validated prompt with MaxAttempts set is answered wrong that many times
+var ErrTooManyAttempts = errors.New("too many invalid attempts")
+
+// ErrInterrupted is returned by every prompt when the process gets SIGINT or
+// SIGTERM, after the terminal has been restored
+var ErrInterrupted = core.ErrInterrupted
+
+// wizardActive turns on the back key (ctrl+b/shift+tab) while a Wizard runs
+var wizardActive bool
+
+// isBackKey reports whether the key should go back a wizard step
+func isBackKey(msg tea.KeyMsg) bool {
+	if !wizardActive {
+		return false
+	}
+	switch msg.String() {
+	case "ctrl+b", "shift+tab":
+		return true
+	}
+	return false
+}
+
+// helpLine renders a prompt's help text for the terminal width. When the full
+// text doesn't fit it drops to the short form, wrapping that if it still
+// doesn't fit. A width of 0 (size not known yet) always uses the full text.
+// The back key is added while a Wizard runs.
+func helpLine(width int, full, short string) string {
+	if wizardActive {
+		full += " • ctrl+b back"
+		short += " ctrl+b"
+	}
+	if width <= 0 || lipgloss.Width(full) <= width {
+		return helpStyle.Render(full)
+	}
+	if lipgloss.Width(short) <= width {
+		return helpStyle.Render(short)
+	}
+	return helpStyle.Copy().Width(width).Render(short)
+}
+
+// stepProgress is the "Step X of Y" shown at the top of prompts, zero when unset
+var stepProgress struct {
+	current, total int
+}
+
+// SetStepProgress makes the following prompts show "Step current of total" above
+// their title. Wizard.Run sets it for every step; pass 0, 0 to turn it off.
+func SetStepProgress(current, total int) {
+	stepProgress.current = current
+	stepProgress.total = total
+}
+
+// stepLine renders the step progress line, or "" when no progress is set
+func stepLine() string {
+	if stepProgress.total <= 0 {
+		return ""
+	}
+	return stepStyle.Render(fmt.Sprintf("Step %d of %d", stepProgress.current, stepProgress.total)) + "\n"
+}
+
+// textHelpLine is the help line of the text and name inputs
+func textHelpLine(width int) string {
+	confirm, cancel := keyLabel(promptKeys.Confirm), keyLabel(promptKeys.Cancel)
+	return helpLine(width, confirm+" confirm • "+cancel+" cancel", confirm+" "+cancel)
+}
+
+// keyHelp describes a key for the '?' help overlay
+type keyHelp struct {
+	keys string
+	desc string
+}
+
+// selectKeys are the keys handled by selectModel.Update - keep in sync when adding keys
+func selectKeys() []keyHelp {
+	return []keyHelp{
+		{keys: allKeys(promptKeys.Up), desc: "move up"},
+		{keys: allKeys(promptKeys.Down), desc: "move down"},
+		{keys: allKeys(promptKeys.Confirm, promptKeys.Toggle), desc: "select"},
+		{keys: "?", desc: "toggle this help"},
+		{keys: "q/" + allKeys(promptKeys.Cancel), desc: "cancel"},
+	}
+}
+
+// multiSelectKeys are the keys handled by multiSelectModel.Update - keep in sync when adding keys
+func multiSelectKeys() []keyHelp {
+	return []keyHelp{
+		{keys: allKeys(promptKeys.Up), desc: "move up"},
+		{keys: allKeys(promptKeys.Down), desc: "move down"},
+		{keys: allKeys(promptKeys.Toggle), desc: "toggle item"},
+		{keys: allKeys(promptKeys.Confirm), desc: "confirm selection"},
+		{keys: "?", desc: "toggle this help"},
+		{keys: "q/" + allKeys(promptKeys.Cancel), desc: "cancel"},
+	}
+}
+
+// updateHelp handles a key while the help overlay is open and reports
+// whether the overlay should stay open
+func updateHelp(msg tea.KeyMsg) bool {
+	return !(msg.String() == "?" || isListCancel(msg))
+}
+
+// renderHelpOverlay renders the key binding list shown in place of a prompt
+func renderHelpOverlay(label string, keys []keyHelp) string {
+	keyWidth := 0
+	for _, k := range keys {
+		if w := lipgloss.Width(k.keys); w > keyWidth {
+			keyWidth = w
+		}
+	}
+	keyStyle := selectedStyle.Copy().Width(keyWidth + 2)
 	
-	errorStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("196"))
+	var lines strings.Builder
+	for i, k := range keys {
+		lines.WriteString(keyStyle.Render(k.keys) + k.desc)
+		if i < len(keys)-1 {
+			lines.WriteString("\n")
+		}
+	}
 	
-	helpStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241"))
+	var s strings.Builder
+	s.WriteString(promptStyle.Render(core.CurrentIcons().Help + " Keys for: " + label) + "\n")
+	s.WriteString(core.Box(lines.String(), activeContainerBox) + "\n")
+	s.WriteString(helpStyle.Render("? or " + keyLabel(promptKeys.Cancel) + " to close help") + "\n")
+	
+	return s.String()
+}
+
+// TextCase changes the case of a text prompt's answer
+type TextCase int
+
+const (
+	CaseAsTyped TextCase = iota
+	CaseLower
+	CaseUpper
 )
 
-// textInputModel is our bubble tea model for text input
+// TextOptions normalizes what was typed before it is validated and returned,
+// and limits how often validation may fail
+type TextOptions struct {
+	Trim        bool // Drop leading/trailing whitespace, including pasted newlines
+	Case        TextCase
+	MaxAttempts int // Give up with ErrTooManyAttempts after this many failed validations, 0 means unlimited
+}
+
+// attempts counts failed validations against a MaxAttempts limit
+type attempts struct {
+	max    int
+	failed int
+}
+
+// fail records a failed validation and reports whether the limit is reached
+func (a *attempts) fail() bool {
+	a.failed++
+	return a.max > 0 && a.failed >= a.max
+}
+
+// errorLine is the inline validation error, with the attempts left when limited
+func (a attempts) errorLine(err error) string {
+	line := core.CurrentIcons().Error + " " + err.Error()
+	switch left := a.max - a.failed; {
+	case a.max <= 0:
+	case left == 1:
+		line += " (1 attempt left)"
+	default:
+		line += fmt.Sprintf(" (%d attempts left)", left)
+	}
+	return line
+}
+
+// tooMany is the error returned once the limit is reached
+func (a attempts) tooMany(last error) error {
+	return fmt.Errorf("%w (%d): %v", ErrTooManyAttempts, a.failed, last)
+}
+
+// normalize applies the options to a raw value
+func (o TextOptions) normalize(value string) string {
+	if o.Trim {
+		value = strings.TrimSpace(value)
+	}
+	switch o.Case {
+	case CaseLower:
+		value = strings.ToLower(value)
+	case CaseUpper:
+		value = strings.ToUpper(value)
+	}
+	return value
+}
+
+// textInputModel for simple text input prompts
 type textInputModel struct {
 	textInput textinput.Model
-	prompt    string
-	alias     string
+	label     string
+	options   TextOptions
+	validator func(string) error // Optional, runs on enter
 	err       error
+	attempts  attempts
+	gaveUp    bool // options.MaxAttempts reached, err holds the last failure
 	done      bool
+	cancelled bool // Esc or ctrl+c, rather than an empty answer
+	width     int  // Terminal width, for the help line
+	back      bool
 	value     string
 }
 
-// Initialize the model
-func newTextInputModel(prompt, alias string) textInputModel {
+// Initialize text input model
+func newTextInputModel(label string, defaultValue string) textInputModel {
 	ti := textinput.New()
-	ti.Placeholder = alias
+	if defaultValue != "" {
+		ti.SetValue(defaultValue)
+	}
 	ti.Focus()
 	ti.CharLimit = 156
 	ti.Width = 50
 
 	return textInputModel{
 		textInput: ti,
-		prompt:    prompt,
-		alias:     alias,
+		label:     label,
 	}
 }
 
-// Init is called once when the program starts
 func (m textInputModel) Init() tea.Cmd {
 	return textinput.Blink
 }
 
-// Update handles all input and updates the model
 func (m textInputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
 	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyEnter:
-			value := m.textInput.Value()
-			if value == "" {
-				value = m.alias
-			}
+		if isBackKey(msg) {
+			m.back = true
+			m.done = true
+			return m, tea.Quit
+		}
+		switch {
+		case key.Matches(msg, promptKeys.Confirm):
+			// Validate and return the normalized value, not the raw input
+			value := m.options.normalize(m.textInput.Value())
 			
-			// Validation
-			err := survey.AskOne(&prompt, &value)
-			if err != nil {
-				m.err = err
-				return m, nil
+			// Run validation - keep the prompt open and show the error inline
+			if m.validator != nil {
+				if err := m.validator(value); err != nil {
+					m.err = err
+					if m.attempts.fail() {
+						m.gaveUp = true
+						m.done = true
+						return m, tea.Quit
+					}
+					return m, nil
+				}
 			}
 			
 			m.value = value
 			m.done = true
 			return m, tea.Quit
 			
-		case tea.KeyCtrlC, tea.KeyEsc:
+		case isCancel(msg):
+			m.cancelled = true
 			m.done = true
 			return m, tea.Quit
 		}
 	}
 
-	m.textInput, cmd = m.textInput.Update(msg)
+	before := m.textInput.Value()
+	m.textInput, cmd = m.textInput.Update(cleanPaste(msg))
+	
+	// Clear the error once the user edits the value, not on a cursor blink
+	if m.textInput.Value() != before {
+		m.err = nil
+	}
+	
 	return m, cmd
 }
 
-// View renders the UI
 func (m textInputModel) View() string {
 	if m.done {
 		return ""
 	}
 
 	var s strings.Builder
+	icons := core.CurrentIcons()
+	s.WriteString(stepLine())
 	
-	// Prompt
-	s.WriteString(promptStyle.Render(m.prompt) + "\n")
+	// Title
+	s.WriteString(promptStyle.Render(icons.Text + " " + m.label) + "\n")
 	
-	// Input field
-	s.WriteString(m.textInput.View() + "\n\n")
-	
-	// Error message if any
+	// Input field in a bordered container
+	inputContent := m.textInput.View()
 	if m.err != nil {
-		s.WriteString(errorStyle.Render("✗ " + m.err.Error()) + "\n\n")
+		s.WriteString(core.Box(inputContent, errorContainerBox) + "\n")
+		s.WriteString(errorStyle.Render(m.attempts.errorLine(m.err)) + "\n\n")
+	} else {
+		s.WriteString(core.Box(inputContent, activeContainerBox) + "\n")
 	}
 	
 	// Help text
-	s.WriteString(helpStyle.Render("enter: confirm • esc: cancel"))
+	s.WriteString(textHelpLine(m.width) + "\n")
 	
 	return s.String()
 }
 
-// PromptSoleID - Drop-in replacement for the survey version
-func PromptSoleID(prompt string, alias string) (string, error) {
-	model := newTextInputModel(prompt, alias)
-	
-	p := tea.NewProgram(model)
-	finalModel, err := p.Run()
+// requireValue turns a (value, cancelled, err) result into the two-value
+// form the older prompts return, where backing out and an empty answer are
+// both ErrCancelled
+func requireValue(value string, cancelled bool, err error) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if cancelled || value == "" {
+		return "", ErrCancelled
+	}
+	return value, nil
+}
+
+// PromptText asks for a single line of text. The validator is optional; when set,
+// the prompt stays open with an inline error until the value passes.
+func PromptText(label, defaultValue string, validator func(string) error) (string, error) {
+	return PromptTextWithOptions(label, defaultValue, validator, TextOptions{})
+}
+
+// PromptTextWithOptions is PromptText with the answer trimmed and/or case
+// converted first. The validator sees the normalized value.
+func PromptTextWithOptions(label, defaultValue string, validator func(string) error, opts TextOptions) (string, error) {
+	return requireValue(PromptTextResult(label, defaultValue, validator, opts))
+}
+
+// PromptTextResult is PromptTextWithOptions that tells the outcomes apart:
+// cancelled is true when the user backed out, an empty value with neither
+// cancelled nor err means they submitted an empty answer.
+func PromptTextResult(label, defaultValue string, validator func(string) error, opts TextOptions) (value string, cancelled bool, err error) {
+	model := newTextInputModel(label, defaultValue)
+	model.validator = validator
+	model.options = opts
+	model.attempts.max = opts.MaxAttempts
+	
+	finalModel, err := runProgram(model)
+	if err != nil {
+		return "", false, err
+	}
 	
 	m := finalModel.(textInputModel)
-	if m.value == "" {
-		return "", fmt.Errorf("cancelled")
+	if m.back {
+		return "", false, ErrBack
+	}
+	if m.gaveUp {
+		return "", false, m.attempts.tooMany(m.err)
+	}
+	if !m.done || m.cancelled {
+		return "", true, nil
 	}
 	
-	return m.value, nil
+	return m.value, false, nil
 }
 
-// For PromptEnv with selection, here's a simple select model
+// SoleIDPattern is the accepted shape of a SOLID ID. Letters, digits, '-' and
+// '_', starting with a letter or digit.
+var SoleIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// ValidateSoleID rejects blank IDs and IDs that don't match SoleIDPattern
+func ValidateSoleID(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("sole ID is required")
+	}
+	if !SoleIDPattern.MatchString(id) {
+		return fmt.Errorf("%q is not a valid sole ID (letters, digits, '-' and '_' only)", id)
+	}
+	return nil
+}
+
+// PromptSoleID - matches your current function signature
+// Takes the id from flags as parameter, falling back to $MERNA_SOLE_ID
+// (see EnvSoleID)
+func PromptSoleID(id string) (string, error) {
+	return PromptSoleIDValidated(id, ValidateSoleID)
+}
+
+// PromptSoleIDValidated prompts for the sole ID and only returns once validate
+// accepts it, showing the validation error inline. A nil validate accepts anything.
+// Whitespace around the ID (e.g. from pasting) is trimmed.
+func PromptSoleIDValidated(id string, validate func(string) error) (string, error) {
+	return PromptSoleIDWithOptions(id, validate, TextOptions{Trim: true})
+}
+
+// PromptSoleIDWithOptions is PromptSoleIDValidated with custom normalization,
+// e.g. TextOptions{Trim: true, Case: CaseUpper}
+func PromptSoleIDWithOptions(id string, validate func(string) error, opts TextOptions) (string, error) {
+	prompt := "Enter the sole ID of business application:"
+	id = envDefault(id, EnvSoleID)
+	if core.NonInteractive() {
+		return autoText("the sole ID", id, EnvSoleID, validate, opts)
+	}
+	return PromptTextWithOptions(prompt, id, validate, opts)
+}
+
+// selectModel for selection prompts
 type selectModel struct {
-	choices  []string
-	cursor   int
-	selected string
-	prompt   string
-	done     bool
+	choices       []string
+	cursor        int
+	selected      string
+	selectedIndex int // -1 until a choice is made
+	headers       map[int]bool // Group header rows, shown but never selectable
+	disabled      map[int]string // Unavailable rows, shown dimmed with their reason, never selectable
+	note          string // Inline note under the list, e.g. why enter did nothing
+	label         string
+	done          bool
+	width         int  // Terminal width, for the help line
+	back          bool
+	showHelp      bool
 }
 
-func newSelectModel(prompt string, choices []string) selectModel {
+func newSelectModel(label string, choices []string, defaultChoice string) selectModel {
+	cursor := 0
+	// Set cursor to default choice if provided
+	for i, choice := range choices {
+		if choice == defaultChoice {
+			cursor = i
+			break
+		}
+	}
+	
 	return selectModel{
-		prompt:  prompt,
-		choices: choices,
+		label:         label,
+		choices:       choices,
+		cursor:        cursor,
+		selectedIndex: -1,
 	}
 }
 
@@ -151,21 +460,48 @@ func (m selectModel) Init() tea.Cmd {
 
 func (m selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
-			if m.cursor > 0 {
-				m.cursor--
+		if m.showHelp {
+			m.showHelp = updateHelp(msg)
+			if msg.String() == "ctrl+c" {
+				m.done = true
+				return m, tea.Quit
 			}
-		case "down", "j":
-			if m.cursor < len(m.choices)-1 {
-				m.cursor++
+			return m, nil
+		}
+		
+		if isBackKey(msg) {
+			m.back = true
+			m.done = true
+			return m, tea.Quit
+		}
+		
+		m.note = ""
+		switch {
+		case msg.String() == "?":
+			m.showHelp = true
+		case key.Matches(msg, promptKeys.Up):
+			m.move(-1)
+		case key.Matches(msg, promptKeys.Down):
+			m.move(1)
+		case key.Matches(msg, promptKeys.Confirm, promptKeys.Toggle):
+			if reason, ok := m.disabled[m.cursor]; ok {
+				m.note = m.choices[m.cursor] + " is unavailable"
+				if reason != "" {
+					m.note += ": " + reason
+				}
+				return m, nil
+			}
+			if !m.selectable(m.cursor) {
+				return m, nil
 			}
-		case "enter", " ":
 			m.selected = m.choices[m.cursor]
+			m.selectedIndex = m.cursor
 			m.done = true
 			return m, tea.Quit
-		case "q", "esc", "ctrl+c":
+		case isListCancel(msg):
 			m.done = true
 			return m, tea.Quit
 		}
@@ -173,44 +509,901 @@ func (m selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// move steps the cursor by delta, skipping rows that can't be selected
+func (m *selectModel) move(delta int) {
+	for i := m.cursor + delta; i >= 0 && i < len(m.choices); i += delta {
+		if m.selectable(i) {
+			m.cursor = i
+			return
+		}
+	}
+}
+
+func (m selectModel) selectable(i int) bool {
+	_, disabled := m.disabled[i]
+	return !m.headers[i] && !disabled
+}
+
 func (m selectModel) View() string {
 	if m.done {
 		return ""
 	}
+	
+	if m.showHelp {
+		return renderHelpOverlay(m.label, selectKeys())
+	}
 
-	s := promptStyle.Render(m.prompt) + "\n\n"
+	var s strings.Builder
+	icons := core.CurrentIcons()
+	s.WriteString(stepLine())
 	
+	// Title with icon
+	s.WriteString(promptStyle.Render(icons.Select + " " + m.label) + "\n")
+	
+	// Build choices list
+	var choices strings.Builder
 	for i, choice := range m.choices {
 		cursor := "  "
+		choiceText := choice
+		
+		if m.headers[i] {
+			choices.WriteString(sectionStyle.Render(choice))
+			if i < len(m.choices)-1 {
+				choices.WriteString("\n")
+			}
+			continue
+		}
+		
+		if reason, ok := m.disabled[i]; ok {
+			text := icons.Disabled + " " + choice
+			if reason != "" {
+				text += " (" + reason + ")"
+			}
+			choices.WriteString("  " + disabledStyle.Render(text))
+			if i < len(m.choices)-1 {
+				choices.WriteString("\n")
+			}
+			continue
+		}
+		
 		if m.cursor == i {
-			cursor = "> "
-			choice = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("86")).Render(choice)
+			cursor = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Render(icons.Cursor + " ")
+			choiceText = selectedStyle.Render(choice)
+		} else {
+			choiceText = lipgloss.NewStyle().Foreground(lipgloss.Color("252")).Render(choice)
+		}
+		
+		choices.WriteString(cursor + choiceText)
+		if i < len(m.choices)-1 {
+			choices.WriteString("\n")
 		}
-		s += cursor + choice + "\n"
 	}
 	
-	s += "\n" + helpStyle.Render("↑/↓: navigate • enter: select • esc: cancel")
+	// Render choices in a bordered container
+	s.WriteString(core.Box(choices.String(), activeContainerBox) + "\n")
+	
+	if m.note != "" {
+		s.WriteString(errorStyle.Render(icons.Warning + " " + m.note) + "\n")
+	}
 	
-	return s
+	// Help text
+	up, down := keyLabel(promptKeys.Up), keyLabel(promptKeys.Down)
+	confirm, cancel := keyLabel(promptKeys.Confirm), keyLabel(promptKeys.Cancel)
+	s.WriteString(helpLine(m.width,
+		fmt.Sprintf("%s%s navigate • %s select • %s cancel • ? help", up, down, confirm, cancel),
+		fmt.Sprintf("%s%s %s %s ?", up, down, confirm, cancel)) + "\n")
+	
+	return s.String()
 }
 
-// PromptEnv - Drop-in replacement for environment selection
-func PromptEnv(prompt string) (string, error) {
-	// Get environments from your survey.Select call
-	envs := []string{"test", "prod"}  // You'd fetch these as before
+// PromptSelectIndex asks the user to pick one of choices and returns its index,
+// so callers can map it back to a parallel slice (e.g. IDs behind pretty names).
+// defaultIdx is where the cursor starts, use -1 for the first item.
+func PromptSelectIndex(label string, choices []string, defaultIdx int) (int, error) {
+	model := newSelectModel(label, choices, "")
+	if defaultIdx >= 0 && defaultIdx < len(choices) {
+		model.cursor = defaultIdx
+	}
+	return runSelect(model)
+}
+
+// PromptSelectIndexResult is PromptSelectIndex that reports backing out
+// as cancelled (with index -1) instead of ErrCancelled
+func PromptSelectIndexResult(label string, choices []string, defaultIdx int) (index int, cancelled bool, err error) {
+	model := newSelectModel(label, choices, "")
+	if defaultIdx >= 0 && defaultIdx < len(choices) {
+		model.cursor = defaultIdx
+	}
+	return runSelectResult(model)
+}
+
+// runSelect runs a select model and returns the picked index
+func runSelect(model selectModel) (int, error) {
+	idx, cancelled, err := runSelectResult(model)
+	if err == nil && cancelled {
+		return -1, ErrCancelled
+	}
+	return idx, err
+}
+
+// runSelectResult is runSelect that reports backing out as cancelled
+func runSelectResult(model selectModel) (int, bool, error) {
+	if len(model.choices) == 0 {
+		return -1, false, fmt.Errorf("no choices to select from")
+	}
 	
-	model := newSelectModel(prompt, envs)
+	// Never start on a row that can't be picked
+	if !model.selectable(model.cursor) {
+		model.move(1)
+		if !model.selectable(model.cursor) {
+			return -1, false, fmt.Errorf("no selectable choices")
+		}
+	}
 	
-	p := tea.NewProgram(model)
-	finalModel, err := p.Run()
+	finalModel, err := runProgram(model, programOptions()...)
 	if err != nil {
-		return "", err
+		return -1, false, err
 	}
 	
 	m := finalModel.(selectModel)
-	if m.selected == "" {
-		return "", fmt.Errorf("cancelled")
+	if m.back {
+		return -1, false, ErrBack
+	}
+	if !m.done || m.selectedIndex < 0 {
+		return -1, true, nil
+	}
+	
+	return m.selectedIndex, false, nil
+}
+
+// PromptEnv - matches your current function signature
+// Takes the env from flags, falling back to $MERNA_ENV (see EnvEnv)
+func PromptEnv(env string) (string, error) {
+	prompt := "Enter the environment of the resource:"
+	env = envDefault(env, EnvEnv)
+	
+	// You would fetch these from your actual environment list
+	// This is just example data
+	environments := []string{"test", "prod"}
+	
+	if core.NonInteractive() {
+		idx, err := autoChoice("environment", env, EnvEnv, environments)
+		if err != nil {
+			return "", err
+		}
+		return strings.ToUpper(environments[idx]), nil
+	}
+	
+	// Start on the env from flags if it's one of the choices
+	defaultIdx := -1
+	for i, e := range environments {
+		if strings.EqualFold(e, env) {
+			defaultIdx = i
+			break
+		}
+	}
+	
+	idx, err := PromptSelectIndex(prompt, environments, defaultIdx)
+	if err != nil {
+		return "", err
+	}
+	
+	// Convert to uppercase as per original implementation
+	return strings.ToUpper(environments[idx]), nil
+}
+
+// confirmModel for yes/no questions
+type confirmModel struct {
+	label      string
+	defaultYes bool
+	answer     bool
+	done       bool
+	width      int  // Terminal width, for the help line
+	answered   bool
+}
+
+func (m confirmModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m confirmModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "y" || msg.String() == "Y":
+			m.answer, m.answered = true, true
+		case msg.String() == "n" || msg.String() == "N":
+			m.answer, m.answered = false, true
+		case key.Matches(msg, promptKeys.Confirm):
+			m.answer, m.answered = m.defaultYes, true
+		case isListCancel(msg):
+		default:
+			return m, nil
+		}
+		m.done = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m confirmModel) View() string {
+	if m.done {
+		return ""
+	}
+	
+	choices := "y/N"
+	if m.defaultYes {
+		choices = "Y/n"
+	}
+	
+	var s strings.Builder
+	s.WriteString(stepLine())
+	s.WriteString(promptStyle.Render(core.CurrentIcons().Question + " " + m.label + " (" + choices + ")") + "\n")
+	confirm, cancel := keyLabel(promptKeys.Confirm), keyLabel(promptKeys.Cancel)
+	s.WriteString(helpLine(m.width,
+		"y yes • n no • "+confirm+" default • "+cancel+" cancel",
+		"y n "+confirm+" "+cancel) + "\n")
+	
+	return s.String()
+}
+
+// PromptConfirm asks a yes/no question. Enter picks defaultYes, esc returns ErrCancelled.
+// With --assume-yes it answers yes without asking.
+func PromptConfirm(label string, defaultYes bool) (bool, error) {
+	answer, cancelled, err := PromptConfirmResult(label, defaultYes)
+	if err == nil && cancelled {
+		return false, ErrCancelled
+	}
+	return answer, err
+}
+
+// PromptConfirmResult is PromptConfirm that reports esc as cancelled
+// instead of ErrCancelled, so it can't be mistaken for "no"
+func PromptConfirmResult(label string, defaultYes bool) (answer, cancelled bool, err error) {
+	if core.NonInteractive() {
+		answer, err = autoConfirm(label)
+		return answer, false, err
+	}
+	
+	finalModel, err := runProgram(confirmModel{label: label, defaultYes: defaultYes}, programOptions()...)
+	if err != nil {
+		return false, false, err
+	}
+	
+	m := finalModel.(confirmModel)
+	if !m.answered {
+		return false, true, nil
+	}
+	
+	return m.answer, false, nil
+}
+
+// Type definition to match your existing code
+type NameValidator func(string) bool
+
+// PromptName - matches your signature with bool validator
+func PromptName(name string, requirements []string, isNameValid NameValidator) (string, error) {
+	var validate func(string) error
+	if isNameValid != nil {
+		validate = func(value string) error {
+			if !isNameValid(value) {
+				return fmt.Errorf("invalid name format")
+			}
+			return nil
+		}
+	}
+	return PromptNameValidated(name, requirements, validate)
+}
+
+// PromptNameValidated is PromptName with a validator that says what's wrong,
+// shown inline, e.g. validate.All(validate.MaxLen(63), validate.Lowercase())
+func PromptNameValidated(name string, requirements []string, validate func(string) error) (string, error) {
+	return PromptNameWithAttempts(name, requirements, validate, 0)
+}
+
+// PromptNameWithAttempts is PromptNameValidated that gives up with
+// ErrTooManyAttempts after maxAttempts failed validations (0 means unlimited)
+func PromptNameWithAttempts(name string, requirements []string, validate func(string) error, maxAttempts int) (string, error) {
+	return requireValue(PromptNameResult(name, requirements, validate, maxAttempts))
+}
+
+// PromptNameResult is PromptNameWithAttempts that tells backing out
+// (cancelled) apart from an empty name and from errors
+func PromptNameResult(name string, requirements []string, validate func(string) error, maxAttempts int) (value string, cancelled bool, err error) {
+	prompt := "Enter the name of the cache:"
+	
+	// Create a custom model with validation
+	model := newNameInputModel(prompt, name, requirements, validate)
+	model.attempts.max = maxAttempts
+	
+	finalModel, err := runProgram(model)
+	if err != nil {
+		return "", false, err
+	}
+	
+	m := finalModel.(nameInputModel)
+	if m.back {
+		return "", false, ErrBack
+	}
+	if m.gaveUp {
+		return "", false, m.attempts.tooMany(m.err)
+	}
+	if !m.done || m.cancelled {
+		return "", true, nil
+	}
+	
+	return m.value, false, nil
+}
+
+// nameInputModel with validation support
+type nameInputModel struct {
+	textInput    textinput.Model
+	label        string
+	requirements []string
+	validator    func(string) error // Optional, runs on enter
+	err          error
+	attempts     attempts
+	gaveUp       bool // MaxAttempts reached, err holds the last failure
+	done         bool
+	cancelled    bool // Esc or ctrl+c, rather than an empty answer
+	width        int  // Terminal width, for the help line
+	back         bool
+	value        string
+}
+
+func newNameInputModel(label, defaultValue string, requirements []string, validator func(string) error) nameInputModel {
+	ti := textinput.New()
+	if defaultValue != "" {
+		ti.SetValue(defaultValue)
+	}
+	ti.Focus()
+	ti.CharLimit = 156
+	ti.Width = 50
+
+	return nameInputModel{
+		textInput:    ti,
+		label:        label,
+		requirements: requirements,
+		validator:    validator,
+	}
+}
+
+func (m nameInputModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m nameInputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case tea.KeyMsg:
+		if isBackKey(msg) {
+			m.back = true
+			m.done = true
+			return m, tea.Quit
+		}
+		switch {
+		case key.Matches(msg, promptKeys.Confirm):
+			value := m.textInput.Value()
+			
+			// Run validation - keep the prompt open and show why it failed
+			if m.validator != nil {
+				if err := m.validator(value); err != nil {
+					m.err = err
+					if m.attempts.fail() {
+						m.gaveUp = true
+						m.done = true
+						return m, tea.Quit
+					}
+					return m, nil
+				}
+			}
+			
+			m.value = value
+			m.done = true
+			return m, tea.Quit
+			
+		case isCancel(msg):
+			m.cancelled = true
+			m.done = true
+			return m, tea.Quit
+		}
+	}
+
+	before := m.textInput.Value()
+	m.textInput, cmd = m.textInput.Update(cleanPaste(msg))
+	
+	// Clear the error once the user edits the value, not on a cursor blink
+	if m.textInput.Value() != before {
+		m.err = nil
+	}
+	
+	return m, cmd
+}
+
+func (m nameInputModel) View() string {
+	if m.done {
+		return ""
+	}
+
+	var s strings.Builder
+	icons := core.CurrentIcons()
+	s.WriteString(stepLine())
+	
+	// Title with icon
+	s.WriteString(promptStyle.Render(icons.Edit + " " + m.label) + "\n")
+	
+	// Requirements in a bordered box
+	if len(m.requirements) > 0 {
+		var reqText strings.Builder
+		reqText.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("243")).Bold(true).Render("Requirements:") + "\n")
+		for i, req := range m.requirements {
+			reqText.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Render("  • " + req))
+			if i < len(m.requirements)-1 {
+				reqText.WriteString("\n")
+			}
+		}
+		s.WriteString(core.Box(reqText.String(), requirementsBox) + "\n")
+	}
+	
+	// Input field in a bordered container
+	inputContent := m.textInput.View()
+	if m.err != nil {
+		s.WriteString(core.Box(inputContent, errorContainerBox) + "\n")
+		s.WriteString(errorStyle.Render(m.attempts.errorLine(m.err)) + "\n\n")
+	} else {
+		s.WriteString(core.Box(inputContent, activeContainerBox) + "\n")
 	}
 	
-	return m.selected, nil
+	// Help text
+	s.WriteString(textHelpLine(m.width) + "\n")
+	
+	return s.String()
+}
+
+// cacheRegions are the regions a cache can be created in
+var cacheRegions = []string{"us-east-1", "us-west-2"}
+
+// PromptForCacheRegions - for multi-select of regions
+func PromptForCacheRegions() ([]string, error) {
+	return promptCacheRegions(nil)
+}
+
+// promptCacheRegions is PromptForCacheRegions with regions already checked,
+// e.g. when going back to the step in a Wizard
+func promptCacheRegions(defaults []string) ([]string, error) {
+	return promptRegions(cacheRegions, defaults, nil)
+}
+
+// PromptRegions asks for one or more of choices. validate, if set, checks
+// the checked regions together (e.g. "prod needs both us-east-1 and
+// us-west-2"); its error is shown under the list and the user picks again.
+func PromptRegions(choices []string, validate func([]string) error) ([]string, error) {
+	return promptRegions(choices, nil, validate)
+}
+
+func promptRegions(choices, defaults []string, validate func([]string) error) ([]string, error) {
+	defaults = envDefaultList(defaults, EnvRegions)
+	if core.NonInteractive() {
+		return autoChoices("region", defaults, EnvRegions, choices, validate)
+	}
+	
+	model := newMultiSelectModel("Select the cache region(s):", choices, defaults)
+	model.validator = func(selected []string) error {
+		if len(selected) == 0 {
+			return fmt.Errorf("at least one region must be selected")
+		}
+		if validate != nil {
+			return validate(selected)
+		}
+		return nil
+	}
+	
+	indices, err := runMultiSelect(model)
+	if err != nil {
+		return nil, err
+	}
+	
+	selected := make([]string, len(indices))
+	for i, idx := range indices {
+		selected[i] = choices[idx]
+	}
+	return selected, nil
+}
+
+// PromptEditRegions edits the regions a cache is configured with: current
+// starts checked, the rest of available can be added, and at least one must
+// stay. Current regions missing from available are still listed so they can
+// be kept or dropped. Items that are added or removed show as such while
+// editing. Returns the final set in list order.
+func PromptEditRegions(current, available []string) ([]string, error) {
+	choices := append([]string(nil), available...)
+	listed := make(map[string]bool, len(available))
+	for _, region := range available {
+		listed[region] = true
+	}
+	for _, region := range current {
+		if !listed[region] {
+			listed[region] = true
+			choices = append(choices, region)
+		}
+	}
+	
+	atLeastOne := func(selected []string) error {
+		if len(selected) == 0 {
+			return fmt.Errorf("at least one region must be selected")
+		}
+		return nil
+	}
+	if core.NonInteractive() {
+		return autoChoices("region", envDefaultList(current, EnvRegions), EnvRegions, choices, atLeastOne)
+	}
+	
+	model := newMultiSelectModel("Edit the cache region(s):", choices, current)
+	model.validator = atLeastOne
+	model.original = make(map[int]bool, len(model.selected))
+	for i := range model.selected {
+		model.original[i] = true
+	}
+	
+	indices, err := runMultiSelect(model)
+	if err != nil {
+		return nil, err
+	}
+	
+	selected := make([]string, len(indices))
+	for i, idx := range indices {
+		selected[i] = choices[idx]
+	}
+	return selected, nil
+}
+
+// PromptMultiSelect asks the user to check any number of choices and
+// returns the checked ones in list order
+func PromptMultiSelect(label string, choices []string) ([]string, error) {
+	return PromptMultiSelectWithDefaults(label, choices, nil)
+}
+
+// PromptMultiSelectWithDefaults is PromptMultiSelect with the choices in defaults
+// already checked, e.g. the regions a cache is currently configured with
+func PromptMultiSelectWithDefaults(label string, choices, defaults []string) ([]string, error) {
+	indices, err := runMultiSelect(newMultiSelectModel(label, choices, defaults))
+	if err != nil {
+		return nil, err
+	}
+	
+	selected := make([]string, len(indices))
+	for i, idx := range indices {
+		selected[i] = choices[idx]
+	}
+	return selected, nil
+}
+
+// PromptMultiSelectIndices is PromptMultiSelect returning the indices of the checked choices
+func PromptMultiSelectIndices(label string, choices []string) ([]int, error) {
+	return runMultiSelect(newMultiSelectModel(label, choices, nil))
+}
+
+// runMultiSelect runs a multi-select model and returns the checked indices
+func runMultiSelect(model multiSelectModel) ([]int, error) {
+	finalModel, err := runProgram(model, programOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	
+	m := finalModel.(multiSelectModel)
+	if m.back {
+		return nil, ErrBack
+	}
+	if !m.done || m.cancelled {
+		return nil, ErrCancelled
+	}
+	
+	return m.getSelectedIndices(), nil
+}
+
+// Option is a choice shown as Label that returns Value when picked,
+// e.g. a human name in front of an opaque ID
+type Option struct {
+	Label    string
+	Value    string
+	Header   bool   // Group heading (e.g. "US", "EU") - shown bold, skipped when navigating
+	Disabled bool   // Shown dimmed with a marker but can't be picked, e.g. a region under maintenance
+	Reason   string // Why it's disabled, shown after the label
+}
+
+// PromptSelectOptions shows the options' labels and returns the picked option's value
+func PromptSelectOptions(label string, options []Option) (string, error) {
+	model := newSelectModel(label, optionLabels(options), "")
+	model.headers = make(map[int]bool)
+	model.disabled = make(map[int]string)
+	for i, opt := range options {
+		if opt.Header {
+			model.headers[i] = true
+		}
+		if opt.Disabled {
+			model.disabled[i] = opt.Reason
+		}
+	}
+	
+	idx, err := runSelect(model)
+	if err != nil {
+		return "", err
+	}
+	return options[idx].Value, nil
+}
+
+// PromptMultiSelectOptions shows the options' labels and returns the checked options' values
+func PromptMultiSelectOptions(label string, options []Option) ([]string, error) {
+	indices, err := PromptMultiSelectIndices(label, optionLabels(options))
+	if err != nil {
+		return nil, err
+	}
+	
+	values := make([]string, len(indices))
+	for i, idx := range indices {
+		values[i] = options[idx].Value
+	}
+	return values, nil
+}
+
+// SelectionOptions changes how the multi-selects return the checked values.
+// The zero value keeps list order.
+type SelectionOptions struct {
+	Sort   bool // Alphabetically instead of in list order
+	Unique bool // Drop repeated values (e.g. a region listed under two groups), keeping the first
+}
+
+// apply sorts and/or dedupes values
+func (o SelectionOptions) apply(values []string) []string {
+	if o.Unique {
+		seen := make(map[string]bool, len(values))
+		unique := values[:0:0]
+		for _, v := range values {
+			if !seen[v] {
+				seen[v] = true
+				unique = append(unique, v)
+			}
+		}
+		values = unique
+	}
+	if o.Sort {
+		sort.Strings(values)
+	}
+	return values
+}
+
+// PromptMultiSelectWithOptions shows the options' labels and returns the checked
+// options' values, sorted and deduped as opts says. Options without a Value
+// return their Label, so plain choices work too.
+func PromptMultiSelectWithOptions(label string, options []Option, opts SelectionOptions) ([]string, error) {
+	indices, err := PromptMultiSelectIndices(label, optionLabels(options))
+	if err != nil {
+		return nil, err
+	}
+	
+	values := make([]string, len(indices))
+	for i, idx := range indices {
+		values[i] = options[idx].Value
+		if values[i] == "" {
+			values[i] = options[idx].Label
+		}
+	}
+	return opts.apply(values), nil
+}
+
+func optionLabels(options []Option) []string {
+	labels := make([]string, len(options))
+	for i, opt := range options {
+		labels[i] = opt.Label
+	}
+	return labels
+}
+
+// multiSelectModel for selecting multiple options
+type multiSelectModel struct {
+	choices   []string
+	selected  map[int]bool
+	cursor    int
+	label     string
+	done      bool
+	width     int  // Terminal width, for the help line
+	cancelled bool
+	back      bool
+	showHelp  bool
+	validator func([]string) error // Optional: checked on confirm, the model stays open while it fails
+	err       error
+	original  map[int]bool // Optional: the items checked before editing, changes from it are marked
+}
+
+// newMultiSelectModel creates the model with any choices listed in defaults pre-checked
+func newMultiSelectModel(label string, choices []string, defaults []string) multiSelectModel {
+	selected := make(map[int]bool)
+	for _, d := range defaults {
+		for i, choice := range choices {
+			if choice == d {
+				selected[i] = true
+			}
+		}
+	}
+	
+	return multiSelectModel{
+		label:    label,
+		choices:  choices,
+		selected: selected,
+	}
+}
+
+func (m multiSelectModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m multiSelectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case tea.KeyMsg:
+		if m.showHelp {
+			m.showHelp = updateHelp(msg)
+			if msg.String() == "ctrl+c" {
+				m.done = true
+				m.cancelled = true
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		
+		if isBackKey(msg) {
+			m.back = true
+			m.done = true
+			return m, tea.Quit
+		}
+		
+		switch {
+		case msg.String() == "?":
+			m.showHelp = true
+		case key.Matches(msg, promptKeys.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, promptKeys.Down):
+			if m.cursor < len(m.choices)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, promptKeys.Toggle):
+			// Toggle selection
+			if m.selected[m.cursor] {
+				delete(m.selected, m.cursor)
+			} else {
+				m.selected[m.cursor] = true
+			}
+			m.err = nil
+		case key.Matches(msg, promptKeys.Confirm):
+			if m.validator != nil {
+				if err := m.validator(m.getSelected()); err != nil {
+					m.err = err
+					return m, nil
+				}
+			}
+			m.done = true
+			return m, tea.Quit
+		case isListCancel(msg):
+			m.done = true
+			m.cancelled = true
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// changeMarker tags item i against the original selection: kept, added
+// or removed. Empty when the model isn't editing one.
+func (m multiSelectModel) changeMarker(i int) string {
+	switch {
+	case m.original == nil:
+		return ""
+	case m.original[i] && m.selected[i]:
+		return disabledStyle.Render(" (current)")
+	case m.original[i]:
+		return errorStyle.Render(" - removed")
+	case m.selected[i]:
+		return successStyle.Render(" + added")
+	}
+	return ""
+}
+
+func (m multiSelectModel) View() string {
+	if m.done {
+		return ""
+	}
+	
+	if m.showHelp {
+		return renderHelpOverlay(m.label, multiSelectKeys())
+	}
+
+	var s strings.Builder
+	icons := core.CurrentIcons()
+	s.WriteString(stepLine())
+	
+	// Title with icon
+	s.WriteString(promptStyle.Render(icons.MultiSelect + " " + m.label + " (Multi-select)") + "\n")
+	
+	// Build choices list with checkboxes
+	var choices strings.Builder
+	for i, choice := range m.choices {
+		cursor := "  "
+		checkbox := icons.Unchecked
+		choiceStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+		
+		if m.selected[i] {
+			checkbox = checkboxStyle.Render(icons.Checked)
+			choiceStyle = successStyle  // Make selected items green
+		} else {
+			checkbox = lipgloss.NewStyle().Foreground(lipgloss.Color("239")).Render(icons.Unchecked)
+		}
+		
+		if m.cursor == i {
+			cursor = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Bold(true).Render(icons.Cursor + " ")
+			if !m.selected[i] {
+				choiceStyle = selectedStyle  // Only use selected style if not already selected
+			}
+		}
+		
+		choices.WriteString(fmt.Sprintf("%s%s %s%s", cursor, checkbox, choiceStyle.Render(choice), m.changeMarker(i)))
+		if i < len(m.choices)-1 {
+			choices.WriteString("\n")
+		}
+	}
+	
+	// Render choices in a bordered container
+	s.WriteString(core.Box(choices.String(), activeContainerBox) + "\n")
+	
+	// Show selected count, or why the selection was refused
+	selectedCount := len(m.getSelected())
+	if m.err != nil {
+		s.WriteString(errorStyle.Render(icons.Error + " " + m.err.Error()) + "\n\n")
+	} else if selectedCount > 0 {
+		s.WriteString(successStyle.Render(fmt.Sprintf("%s %d selected", icons.Success, selectedCount)) + "\n\n")
+	} else {
+		s.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(icons.Warning + " No items selected") + "\n\n")
+	}
+	
+	// Help text
+	up, down := keyLabel(promptKeys.Up), keyLabel(promptKeys.Down)
+	toggle, confirm, cancel := keyLabel(promptKeys.Toggle), keyLabel(promptKeys.Confirm), keyLabel(promptKeys.Cancel)
+	if selectedCount == 0 {
+		s.WriteString(helpLine(m.width,
+			fmt.Sprintf("%s Press %s to select items, then %s to confirm", icons.Warning, strings.ToUpper(toggle), strings.ToUpper(confirm)),
+			fmt.Sprintf("%s %s select, %s confirm", icons.Warning, toggle, confirm)) + "\n")
+	} else {
+		s.WriteString(helpLine(m.width,
+			fmt.Sprintf("%s toggle • %s%s navigate • %s confirm selection • %s cancel • ? help", strings.ToUpper(toggle), up, down, strings.ToUpper(confirm), strings.ToUpper(cancel)),
+			fmt.Sprintf("%s%s %s %s %s ?", up, down, toggle, confirm, cancel)) + "\n")
+	}
+	
+	return s.String()
+}
+
+func (m multiSelectModel) getSelected() []string {
+	var result []string
+	for i, choice := range m.choices {
+		if m.selected[i] {
+			result = append(result, choice)
+		}
+	}
+	return result
+}
+
+func (m multiSelectModel) getSelectedIndices() []int {
+	var result []int
+	for i := range m.choices {
+		if m.selected[i] {
+			result = append(result, i)
+		}
+	}
+	return result
 }
\ No newline at end of file
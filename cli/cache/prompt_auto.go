@@ -0,0 +1,75 @@
+package merna
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// ErrNonInteractive is returned (wrapped) by the prompt wrappers under
+// --non-interactive when a value they need wasn't given by flag or
+// environment variable
+var ErrNonInteractive = errors.New("input needed in non-interactive mode")
+
+// With --non-interactive (see core.NonInteractive) PromptSoleID, PromptEnv,
+// PromptConfirm and PromptForCacheRegions don't start bubbletea: they
+// check and return the value they were given, or fail with ErrNonInteractive.
+
+// autoText is a text prompt's answer without prompting
+func autoText(what, value, envName string, validate func(string) error, opts TextOptions) (string, error) {
+	value = opts.normalize(value)
+	if strings.TrimSpace(value) == "" {
+		return "", fmt.Errorf("%w: %s is required, pass it as a flag or set %s", ErrNonInteractive, what, envName)
+	}
+	if validate != nil {
+		if err := validate(value); err != nil {
+			return "", err
+		}
+	}
+	return value, nil
+}
+
+// autoChoice matches value to one of choices (ignoring case) without prompting
+func autoChoice(what, value, envName string, choices []string) (int, error) {
+	if value == "" {
+		return -1, fmt.Errorf("%w: %s is required, pass it as a flag or set %s", ErrNonInteractive, what, envName)
+	}
+	for i, choice := range choices {
+		if strings.EqualFold(choice, value) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("invalid %s %q, must be one of %s", what, value, strings.Join(choices, ", "))
+}
+
+// autoConfirm answers a confirmation without prompting: yes with
+// --assume-yes, an error otherwise, since guessing could do the wrong thing
+func autoConfirm(label string) (bool, error) {
+	if core.AssumeYes() {
+		return true, nil
+	}
+	return false, fmt.Errorf("%w: %q needs confirming, pass --assume-yes", ErrNonInteractive, label)
+}
+
+// autoChoices checks values against choices without prompting
+func autoChoices(what string, values []string, envName string, choices []string, validate func([]string) error) ([]string, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w: %s is required, set %s", ErrNonInteractive, what, envName)
+	}
+	selected := make([]string, 0, len(values))
+	for _, value := range values {
+		i, err := autoChoice(what, value, envName, choices)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, choices[i])
+	}
+	if validate != nil {
+		if err := validate(selected); err != nil {
+			return nil, err
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,81 @@
+package merna
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// ErrEditorNotFound is returned by PromptEditor when neither $EDITOR nor the
+// fallback editor can be started
+var ErrEditorNotFound = errors.New("no editor found, set $EDITOR")
+
+// ErrEmptyEdit is returned by PromptEditor when the file was saved empty,
+// which editors (git's commit message included) treat as "abort"
+var ErrEmptyEdit = errors.New("empty value, nothing saved")
+
+// editorCommand splits $EDITOR into the command and its arguments (e.g.
+// "code --wait"), falling back to vi, or notepad on Windows
+func editorCommand() []string {
+	if fields := strings.Fields(os.Getenv("EDITOR")); len(fields) > 0 {
+		return fields
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
+
+// PromptEditor lets the user edit initial in their editor, for multi-line or
+// complex values. It's written to a temp file ending in fileExt (e.g. ".yaml",
+// so the editor highlights it), and the saved content is returned without
+// its trailing newline.
+func PromptEditor(label, initial, fileExt string) (string, error) {
+	editor := editorCommand()
+	path, err := exec.LookPath(editor[0])
+	if err != nil {
+		return "", fmt.Errorf("%w (tried %s)", ErrEditorNotFound, editor[0])
+	}
+
+	if fileExt != "" && !strings.HasPrefix(fileExt, ".") {
+		fileExt = "." + fileExt
+	}
+	f, err := os.CreateTemp("", "edit-*"+fileExt)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(initial); err != nil {
+		f.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	fmt.Fprintln(os.Stderr, promptStyle.Render(core.CurrentIcons().Edit+" "+label+" (opening "+editor[0]+", save and close to continue)"))
+
+	cmd := exec.Command(path, append(editor[1:], f.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s exited with an error: %w", editor[0], err)
+	}
+
+	edited, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	value := strings.TrimSuffix(strings.TrimSuffix(string(edited), "\n"), "\r")
+	if strings.TrimSpace(value) == "" {
+		return "", ErrEmptyEdit
+	}
+	return value, nil
+}
@@ -0,0 +1,52 @@
+package merna
+
+import (
+	"os"
+	"strings"
+)
+
+// Environment variables that pre-seed prompts, e.g. in CI. The value a prompt
+// starts with is, in order:
+//
+//  1. the value passed in (from a flag), when it isn't empty
+//  2. the environment variable, when it's set and not blank
+//  3. the prompt's empty default
+//
+// They only choose the starting value, the prompt is still shown, unless
+// --non-interactive is set and they are the answer. Turn them off with
+// SetEnvDefaults(false), e.g. for a --no-env flag.
+const (
+	EnvSoleID  = "MERNA_SOLE_ID" // PromptSoleID and its variants
+	EnvEnv     = "MERNA_ENV"     // PromptEnv
+	EnvRegions = "MERNA_REGIONS" // PromptForCacheRegions and PromptRegions, comma-separated
+)
+
+// envDefaults reads the Env* variables when a prompt gets an empty value
+var envDefaults = true
+
+// SetEnvDefaults turns the MERNA_* environment fallbacks on or off
+func SetEnvDefaults(on bool) {
+	envDefaults = on
+}
+
+// envDefault returns value, or $name when value is empty and the fallbacks are on
+func envDefault(value, name string) string {
+	if value != "" || !envDefaults {
+		return value
+	}
+	return strings.TrimSpace(os.Getenv(name))
+}
+
+// envDefaultList is envDefault for a comma-separated list
+func envDefaultList(values []string, name string) []string {
+	if len(values) > 0 {
+		return values
+	}
+	var list []string
+	for _, v := range strings.Split(envDefault("", name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,90 @@
+package merna
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// PromptKeyMap holds the keys shared by the text, name, select and
+// multi-select prompts. Rebind them with SetPromptKeyMap, e.g. when esc is
+// captured by a terminal multiplexer:
+//
+//	merna.SetPromptKeyMap(merna.PromptKeyMap{
+//		Cancel: key.NewBinding(key.WithKeys("ctrl+g"), key.WithHelp("ctrl+g", "cancel")),
+//	})
+//
+// ctrl+c always cancels, whatever Cancel is bound to, and q also cancels
+// the list prompts, where it can't be typed.
+type PromptKeyMap struct {
+	Confirm key.Binding
+	Cancel  key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Toggle  key.Binding // Checks an item in multi-selects, also picks one in selects
+}
+
+// DefaultPromptKeyMap returns the default prompt bindings
+func DefaultPromptKeyMap() PromptKeyMap {
+	return PromptKeyMap{
+		Confirm: key.NewBinding(key.WithKeys("enter"), key.WithHelp("↵", "confirm")),
+		Cancel:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		Up:      key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑", "move up")),
+		Down:    key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓", "move down")),
+		Toggle:  key.NewBinding(key.WithKeys(" ", "x"), key.WithHelp("space", "toggle item")),
+	}
+}
+
+// promptKeys are the bindings every prompt uses
+var promptKeys = DefaultPromptKeyMap()
+
+// SetPromptKeyMap changes the keys of every prompt. Bindings left unset keep their defaults.
+func SetPromptKeyMap(k PromptKeyMap) {
+	promptKeys = k.withDefaults()
+}
+
+// withDefaults fills every unset binding from DefaultPromptKeyMap
+func (k PromptKeyMap) withDefaults() PromptKeyMap {
+	d := DefaultPromptKeyMap()
+	fill := func(b *key.Binding, def key.Binding) {
+		if len(b.Keys()) == 0 {
+			*b = def
+		}
+	}
+	fill(&k.Confirm, d.Confirm)
+	fill(&k.Cancel, d.Cancel)
+	fill(&k.Up, d.Up)
+	fill(&k.Down, d.Down)
+	fill(&k.Toggle, d.Toggle)
+	return k
+}
+
+// isCancel reports whether the key cancels a prompt
+func isCancel(msg tea.KeyMsg) bool {
+	return key.Matches(msg, promptKeys.Cancel) || msg.String() == "ctrl+c"
+}
+
+// isListCancel is isCancel for the list prompts, which also take q
+func isListCancel(msg tea.KeyMsg) bool {
+	return isCancel(msg) || msg.String() == "q"
+}
+
+// keyLabel is a binding's short label for help lines, e.g. "↵"
+func keyLabel(b key.Binding) string {
+	return b.Help().Key
+}
+
+// allKeys lists every key of the bindings for the '?' overlay, e.g. "up/k"
+func allKeys(bindings ...key.Binding) string {
+	var keys []string
+	for _, b := range bindings {
+		for _, k := range b.Keys() {
+			if k == " " {
+				k = "space"
+			}
+			keys = append(keys, k)
+		}
+	}
+	return strings.Join(keys, "/")
+}
@@ -0,0 +1,69 @@
+package merna
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// PasteMode says how the single-line text prompts (text, name and form
+// fields) clean up pasted text. Multi-line values go through PromptEditor,
+// which keeps the newlines.
+type PasteMode int
+
+const (
+	// PasteCollapse drops control characters, turns each run of whitespace
+	// (newlines and tabs included) into one space and trims the ends, so a
+	// pasted "ABC123\n" is just ABC123
+	PasteCollapse PasteMode = iota
+	// PasteStrip drops control characters and turns newlines and tabs into
+	// spaces, keeping the spacing as pasted
+	PasteStrip
+	// PasteRaw leaves pasted text alone
+	PasteRaw
+)
+
+// pasteMode is how pasted text is cleaned up
+var pasteMode = PasteCollapse
+
+// SetPasteMode changes how the text prompts clean up pasted text
+func SetPasteMode(mode PasteMode) {
+	pasteMode = mode
+}
+
+// cleanPaste sanitizes the runes of a pasted key message before it reaches
+// a textinput. Terminals without bracketed paste send pasted text as plain
+// runes, those only lose their control characters, so typing is never
+// trimmed. Other messages are returned as is.
+func cleanPaste(msg tea.Msg) tea.Msg {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || key.Type != tea.KeyRunes || pasteMode == PasteRaw {
+		return msg
+	}
+	mode := pasteMode
+	if !key.Paste {
+		mode = PasteStrip
+	}
+	key.Runes = []rune(sanitizePaste(string(key.Runes), mode))
+	return key
+}
+
+// sanitizePaste applies mode to pasted text
+func sanitizePaste(s string, mode PasteMode) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteRune(' ')
+		case unicode.IsControl(r):
+			// Dropped
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if mode == PasteCollapse {
+		return strings.Join(strings.Fields(b.String()), " ")
+	}
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package merna
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/term"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// ReadChoices reads newline-delimited choices, trimming whitespace and
+// dropping blank lines and duplicates (first occurrence wins)
+func ReadChoices(r io.Reader) ([]string, error) {
+	var choices []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		choice := strings.TrimSpace(scanner.Text())
+		if choice == "" || seen[choice] {
+			continue
+		}
+		seen[choice] = true
+		choices = append(choices, choice)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read choices: %w", err)
+	}
+	return choices, nil
+}
+
+// ReadChoicesFile reads choices from a file, e.g. a --choices-file flag.
+// "-" reads from stdin.
+func ReadChoicesFile(path string) ([]string, error) {
+	if path == "-" {
+		return ReadChoices(os.Stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open choices file: %w", err)
+	}
+	defer f.Close()
+	return ReadChoices(f)
+}
+
+// PromptSelectFromReader is a generic picker over the choices read from r,
+// e.g. `cmd | mycli pick`
+func PromptSelectFromReader(label string, r io.Reader) (string, error) {
+	choices, err := ReadChoices(r)
+	if err != nil {
+		return "", err
+	}
+	idx, err := runSelect(newSelectModel(label, choices, ""))
+	if err != nil {
+		return "", err
+	}
+	return choices[idx], nil
+}
+
+// PromptMultiSelectFromReader is PromptSelectFromReader checking any number of choices
+func PromptMultiSelectFromReader(label string, r io.Reader) ([]string, error) {
+	choices, err := ReadChoices(r)
+	if err != nil {
+		return nil, err
+	}
+	return PromptMultiSelect(label, choices)
+}
+
+// programOptions keeps prompts usable in a pipe: keys are read from the
+// terminal when stdin is piped in, and the UI is drawn on stderr when stdout
+// is piped out so only the answer goes down the pipe
+func programOptions() []tea.ProgramOption {
+	var opts []tea.ProgramOption
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		opts = append(opts, tea.WithInputTTY())
+	}
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		opts = append(opts, tea.WithOutput(os.Stderr))
+	}
+	return opts
+}
+
+// ProgramRunner runs a prompt's model until it quits and returns the final
+// model, which the prompt reads its answer from
+type ProgramRunner func(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error)
+
+// runner runs every prompt, see SetProgramRunner
+var runner ProgramRunner = runTerminal
+
+// SetProgramRunner replaces how prompts are run and returns a func that puts
+// the previous runner back. It's the seam for tests: a runner that drives the
+// model with scripted keys (e.g. teatest) and returns its final model lets a
+// test call PromptSoleID or PromptEnv and check what it returns.
+func SetProgramRunner(r ProgramRunner) (restore func()) {
+	previous := runner
+	runner = r
+	return func() { runner = previous }
+}
+
+// runProgram runs a prompt with the current runner
+func runProgram(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	return runner(model, opts...)
+}
+
+// runTerminal runs a prompt on the terminal. SIGINT/SIGTERM quit it through
+// bubbletea's normal shutdown, so raw mode is always undone, and make it
+// return ErrInterrupted.
+func runTerminal(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	p := tea.NewProgram(model, append(opts, tea.WithoutSignalHandler())...)
+	interrupted, release := core.CatchInterrupt(p.Quit)
+	finalModel, err := p.Run()
+	release()
+	if interrupted() {
+		return finalModel, ErrInterrupted
+	}
+	return finalModel, err
+}
@@ -0,0 +1,201 @@
+package merna
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// The public prompts have one signature each, whatever file they live in
+var (
+	_ func(string) (string, error)                          = PromptSoleID
+	_ func(string) (string, error)                          = PromptEnv
+	_ func(string, []string, NameValidator) (string, error) = PromptName
+	_ func() ([]string, error)                              = PromptForCacheRegions
+)
+
+// Keys for scripted prompts
+var (
+	keyEnter = tea.KeyMsg{Type: tea.KeyEnter}
+	keyEsc   = tea.KeyMsg{Type: tea.KeyEsc}
+	keyDown  = tea.KeyMsg{Type: tea.KeyDown}
+	keySpace = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+)
+
+// typed is the key message for typing s
+func typed(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// scripted makes every prompt in the test run by feeding keys to its
+// Update, the way the terminal would, and clears the MERNA_* fallbacks
+func scripted(t *testing.T, keys ...tea.KeyMsg) {
+	t.Helper()
+	restore := SetProgramRunner(func(model tea.Model, _ ...tea.ProgramOption) (tea.Model, error) {
+		for _, k := range keys {
+			model, _ = model.Update(k)
+		}
+		return model, nil
+	})
+	t.Cleanup(restore)
+	t.Setenv(EnvSoleID, "")
+	t.Setenv(EnvEnv, "")
+	t.Setenv(EnvRegions, "")
+}
+
+func TestPromptSoleID(t *testing.T) {
+	scripted(t, typed(" ABC123 "), keyEnter)
+	got, err := PromptSoleID("")
+	if err != nil || got != "ABC123" {
+		t.Fatalf("PromptSoleID() = %q, %v, want %q, nil", got, err, "ABC123")
+	}
+}
+
+func TestPromptSoleIDCancelled(t *testing.T) {
+	scripted(t, typed("ABC"), keyEsc)
+	if _, err := PromptSoleID(""); !errors.Is(err, ErrCancelled) {
+		t.Fatalf("PromptSoleID() error = %v, want ErrCancelled", err)
+	}
+}
+
+func TestPromptEnv(t *testing.T) {
+	scripted(t, keyDown, keyEnter)
+	got, err := PromptEnv("")
+	if err != nil || got != "PROD" {
+		t.Fatalf("PromptEnv() = %q, %v, want %q, nil", got, err, "PROD")
+	}
+}
+
+func TestPromptName(t *testing.T) {
+	scripted(t, typed("my-cache"), keyEnter)
+	got, err := PromptName("", nil, func(string) bool { return true })
+	if err != nil || got != "my-cache" {
+		t.Fatalf("PromptName() = %q, %v, want %q, nil", got, err, "my-cache")
+	}
+}
+
+func TestPromptResultCancelled(t *testing.T) {
+	scripted(t, typed("half"), keyEsc)
+	if value, cancelled, err := PromptTextResult("Name:", "", nil, TextOptions{}); value != "" || !cancelled || err != nil {
+		t.Errorf("PromptTextResult() = %q, %v, %v, want \"\", true, nil", value, cancelled, err)
+	}
+
+	scripted(t, typed("half"), keyEsc)
+	if value, cancelled, err := PromptNameResult("", nil, nil, 0); value != "" || !cancelled || err != nil {
+		t.Errorf("PromptNameResult() = %q, %v, %v, want \"\", true, nil", value, cancelled, err)
+	}
+
+	// An empty answer isn't a cancel
+	scripted(t, keyEnter)
+	if value, cancelled, err := PromptTextResult("Name:", "", nil, TextOptions{}); value != "" || cancelled || err != nil {
+		t.Errorf("PromptTextResult() = %q, %v, %v, want \"\", false, nil", value, cancelled, err)
+	}
+}
+
+func TestValidationErrorOutlivesBlink(t *testing.T) {
+	tooShort := func(string) error { return errors.New("too short") }
+	text := newTextInputModel("Name:", "")
+	text.validator = tooShort
+	models := map[string]tea.Model{
+		"text": text,
+		"name": newNameInputModel("Name:", "", nil, tooShort),
+	}
+	errOf := func(model tea.Model) error {
+		switch m := model.(type) {
+		case textInputModel:
+			return m.err
+		case nameInputModel:
+			return m.err
+		}
+		return nil
+	}
+	for name, model := range models {
+		t.Run(name, func(t *testing.T) {
+			blink := model.Init()()
+			model, _ = model.Update(keyEnter)
+			if errOf(model) == nil {
+				t.Fatal("enter didn't fail validation")
+			}
+			model, _ = model.Update(blink)
+			if errOf(model) == nil {
+				t.Fatal("a cursor blink cleared the validation error")
+			}
+			model, _ = model.Update(typed("a"))
+			if errOf(model) != nil {
+				t.Error("typing didn't clear the validation error")
+			}
+		})
+	}
+}
+
+func TestPromptForCacheRegions(t *testing.T) {
+	scripted(t, keySpace, keyDown, keySpace, keyEnter)
+	got, err := PromptForCacheRegions()
+	if want := []string{"us-east-1", "us-west-2"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("PromptForCacheRegions() = %v, %v, want %v, nil", got, err, want)
+	}
+}
+
+func TestHelpOverlay(t *testing.T) {
+	help := typed("?")
+	models := map[string]tea.Model{
+		"select":       newSelectModel("Pick one", []string{"a", "b"}, ""),
+		"multi-select": newMultiSelectModel("Pick some", []string{"a", "b"}, nil),
+	}
+	for name, model := range models {
+		t.Run(name, func(t *testing.T) {
+			list := model.View()
+			model, _ = model.Update(help)
+			if overlay := model.View(); overlay == list || !strings.Contains(overlay, "Keys for") {
+				t.Fatalf("'?' didn't open the key help:\n%s", overlay)
+			}
+			model, _ = model.Update(help)
+			if got := model.View(); got != list {
+				t.Fatalf("second '?' didn't close the key help:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestPromptKeyMapFormAndErrorList(t *testing.T) {
+	SetPromptKeyMap(PromptKeyMap{
+		Confirm: key.NewBinding(key.WithKeys("ctrl+s")),
+		Cancel:  key.NewBinding(key.WithKeys("ctrl+g")),
+	})
+	t.Cleanup(func() { SetPromptKeyMap(DefaultPromptKeyMap()) })
+	ctrlS := tea.KeyMsg{Type: tea.KeyCtrlS}
+	ctrlG := tea.KeyMsg{Type: tea.KeyCtrlG}
+
+	var form tea.Model = newFormModel("New cache", []FormField{{Key: "name", Label: "Name"}, {Key: "env", Label: "Env"}})
+	form, _ = form.Update(typed("j"))
+	if f := form.(formModel); f.focus != 0 || f.fields[0].value() != "j" {
+		t.Fatalf("j moved the focus instead of typing: focus %d, value %q", f.focus, f.fields[0].value())
+	}
+	form, _ = form.Update(keyDown)
+	if f := form.(formModel); f.focus != 1 {
+		t.Fatalf("down left the focus on field %d", f.focus)
+	}
+	if form, _ = form.Update(keyEnter); form.(formModel).done {
+		t.Fatal("enter submitted the form after Confirm was rebound")
+	}
+	if form, _ = form.Update(ctrlS); !form.(formModel).submit {
+		t.Fatal("the rebound Confirm didn't submit the form")
+	}
+
+	var list tea.Model = newErrorListModel([]APIError{{Message: "a"}, {Message: "b"}})
+	list, _ = list.Update(keyDown)
+	list, _ = list.Update(ctrlS)
+	if l := list.(errorListModel); l.cursor != 1 || !l.expanded[1] {
+		t.Fatalf("the error list ignored the rebound keys: cursor %d, expanded %v", l.cursor, l.expanded)
+	}
+	if list, _ = list.Update(keyEsc); list.(errorListModel).done {
+		t.Fatal("esc closed the error list after Cancel was rebound")
+	}
+	if list, _ = list.Update(ctrlG); !list.(errorListModel).done {
+		t.Fatal("the rebound Cancel didn't close the error list")
+	}
+}
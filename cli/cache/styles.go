@@ -0,0 +1,83 @@
+package merna
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// Styles using lipgloss
+var (
+	// Title/prompt style with nice padding
+	promptStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("86")).
+			Bold(true).
+			MarginBottom(1)
+
+	// Error style with icon
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Bold(true).
+			PaddingLeft(1)
+
+	// Success style
+	successStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("42")).
+			Bold(true)
+
+	// Help text style
+	helpStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241")).
+			Italic(true)
+
+	// Selected item style
+	selectedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("86")).
+			Bold(true)
+
+	// Group header style in select lists
+	sectionStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("243")).
+			Bold(true)
+
+	// "Step 2 of 4" line above wizard prompts
+	stepStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("243"))
+
+	// Disabled options in select lists
+	disabledStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240"))
+
+	// Checkbox styles
+	checkboxStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("86"))
+)
+
+// Bordered boxes, drawn with core.Box
+var (
+	// Active input container (when focused)
+	activeContainerBox = core.BoxOptions{
+		Color:        core.BoxActiveColor,
+		PaddingY:     1,
+		PaddingX:     2,
+		MarginTop:    1,
+		MarginBottom: 1,
+	}
+
+	// Input container with a validation error
+	errorContainerBox = core.BoxOptions{
+		Color:        core.BoxErrorColor,
+		PaddingY:     1,
+		PaddingX:     2,
+		MarginTop:    1,
+		MarginBottom: 1,
+	}
+
+	// Requirements box
+	requirementsBox = core.BoxOptions{
+		Color:        lipgloss.Color("239"),
+		Square:       true,
+		PaddingX:     1,
+		MarginBottom: 1,
+	}
+)
@@ -0,0 +1,143 @@
+package merna
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WizardAnswers holds the answers collected so far, keyed by WizardStep.Key
+type WizardAnswers map[string]interface{}
+
+// String returns a text/select answer, or "" if the step hasn't run
+func (a WizardAnswers) String(key string) string {
+	s, _ := a[key].(string)
+	return s
+}
+
+// Strings returns a multi-select answer, or nil if the step hasn't run
+func (a WizardAnswers) Strings(key string) []string {
+	s, _ := a[key].([]string)
+	return s
+}
+
+// WizardStep is one prompt in a Wizard. Run gets the answers of the
+// previous steps, so a step can depend on them (e.g. regions for an env).
+type WizardStep struct {
+	Key string
+	Run func(answers WizardAnswers) (interface{}, error)
+}
+
+// Wizard runs a chain of prompts in order. Cancelling any prompt (esc)
+// aborts the whole wizard with ErrCancelled.
+//
+// ctrl+b (or shift+tab) goes back to the previous step, which is re-run with
+// its earlier answer pre-filled. Going back drops whatever was typed into the
+// current step. Answers of later steps are kept as their defaults, but every
+// step runs (and validates) again on the way forward, so a changed early
+// answer can't leave a later one unchecked.
+//
+// Each prompt shows "Step X of Y" above its title while the wizard runs.
+type Wizard struct {
+	Steps []WizardStep
+}
+
+// Run runs every step and returns all the answers
+func (w Wizard) Run() (WizardAnswers, error) {
+	wizardActive = true
+	defer func() {
+		wizardActive = false
+		SetStepProgress(0, 0)
+	}()
+
+	answers := make(WizardAnswers)
+	for i := 0; i < len(w.Steps); {
+		step := w.Steps[i]
+		SetStepProgress(i+1, len(w.Steps))
+		value, err := step.Run(answers)
+		if errors.Is(err, ErrBack) {
+			// On the first step there's nothing to go back to, ask again
+			if i > 0 {
+				i--
+			}
+			continue
+		}
+		if errors.Is(err, ErrCancelled) {
+			return nil, ErrCancelled
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", step.Key, err)
+		}
+		answers[step.Key] = value
+		i++
+	}
+	return answers, nil
+}
+
+// TextStep asks for a line of text, see PromptText
+func TextStep(key, label string, validator func(string) error) WizardStep {
+	return WizardStep{
+		Key: key,
+		Run: func(answers WizardAnswers) (interface{}, error) {
+			return PromptText(label, answers.String(key), validator)
+		},
+	}
+}
+
+// SelectStep picks one of the choices, which may depend on earlier answers
+func SelectStep(key, label string, choices func(WizardAnswers) []string) WizardStep {
+	return WizardStep{
+		Key: key,
+		Run: func(answers WizardAnswers) (interface{}, error) {
+			options := choices(answers)
+			defaultIdx := -1
+			for i, c := range options {
+				if c == answers.String(key) {
+					defaultIdx = i
+				}
+			}
+			idx, err := PromptSelectIndex(label, options, defaultIdx)
+			if err != nil {
+				return nil, err
+			}
+			return options[idx], nil
+		},
+	}
+}
+
+// MultiSelectStep checks any number of the choices
+func MultiSelectStep(key, label string, choices func(WizardAnswers) []string) WizardStep {
+	return WizardStep{
+		Key: key,
+		Run: func(answers WizardAnswers) (interface{}, error) {
+			return PromptMultiSelectWithDefaults(label, choices(answers), answers.Strings(key))
+		},
+	}
+}
+
+// CreateCacheWizard is the create-cache flow: sole ID, name, env, then
+// regions. The arguments are the values from flags, used as defaults until
+// a step has an answer of its own.
+func CreateCacheWizard(id, name, env string, requirements []string, isNameValid NameValidator) Wizard {
+	return Wizard{Steps: []WizardStep{
+		{Key: "id", Run: func(a WizardAnswers) (interface{}, error) {
+			return PromptSoleID(answerOr(a, "id", id))
+		}},
+		{Key: "name", Run: func(a WizardAnswers) (interface{}, error) {
+			return PromptName(answerOr(a, "name", name), requirements, isNameValid)
+		}},
+		{Key: "env", Run: func(a WizardAnswers) (interface{}, error) {
+			return PromptEnv(answerOr(a, "env", env))
+		}},
+		{Key: "regions", Run: func(a WizardAnswers) (interface{}, error) {
+			return promptCacheRegions(a.Strings("regions"))
+		}},
+	}}
+}
+
+// answerOr returns the earlier answer for key, or def if there is none
+func answerOr(answers WizardAnswers, key, def string) string {
+	if s := answers.String(key); s != "" {
+		return s
+	}
+	return def
+}
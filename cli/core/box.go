@@ -0,0 +1,52 @@
+// box.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Box colors. Change these to re-theme every prompt and table box in one place.
+var (
+	BoxColor       = lipgloss.Color("240") // default, e.g. tables and help
+	BoxAccentColor = lipgloss.Color("62")  // containers and detail panels
+	BoxActiveColor = lipgloss.Color("86")  // focused inputs
+	BoxMenuColor   = lipgloss.Color("57")  // popup menus
+	BoxErrorColor  = lipgloss.Color("196") // inputs with a validation error
+)
+
+// BoxOptions controls how Box draws its border
+type BoxOptions struct {
+	Color        lipgloss.Color // "" uses BoxColor
+	Square       bool           // normal instead of rounded corners
+	PaddingY     int
+	PaddingX     int
+	MarginTop    int
+	MarginBottom int
+}
+
+// Box draws content inside a border. With NO_COLOR set the border is drawn
+// without color.
+func Box(content string, opts BoxOptions) string {
+	border := lipgloss.RoundedBorder()
+	if opts.Square {
+		border = lipgloss.NormalBorder()
+	}
+
+	style := lipgloss.NewStyle().
+		BorderStyle(border).
+		Padding(opts.PaddingY, opts.PaddingX).
+		MarginTop(opts.MarginTop).
+		MarginBottom(opts.MarginBottom)
+
+	if _, noColor := os.LookupEnv("NO_COLOR"); !noColor {
+		color := opts.Color
+		if color == "" {
+			color = BoxColor
+		}
+		style = style.BorderForeground(color)
+	}
+
+	return style.Render(content)
+}
@@ -0,0 +1,101 @@
+// errorformat.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errorChain prints every wrapped error under a fatal one (--debug)
+var errorChain bool
+
+// SetErrorChain makes ExitIfError list the wrapped errors, one per layer,
+// under the message
+func SetErrorChain(on bool) {
+	errorChain = on
+}
+
+// formatError renders a fatal error for text output: a stage/exit code
+// line for errors that carry them (terraform.ToolError), the message with
+// repeated parts dropped, and with --debug the chain it was wrapped from
+func formatError(err error) string {
+	var lines []string
+	if header := errorHeader(err); header != "" {
+		lines = append(lines, header)
+	}
+	lines = append(lines, errorMessage(err))
+	if errorChain {
+		lines = append(lines, errorChainLines(err)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// errorHeader is "Stage: init • Exit code: 1", empty for errors that don't
+// know their stage
+func errorHeader(err error) string {
+	var s stager
+	if !errors.As(err, &s) || s.ErrorStage() == "" {
+		return ""
+	}
+	header := "Stage: " + s.ErrorStage()
+	var coder exitCoder
+	if errors.As(err, &coder) && coder.ExitStatus() > 0 {
+		header += fmt.Sprintf(" • Exit code: %d", coder.ExitStatus())
+	}
+	return header
+}
+
+// errorMessage is err's message without repeats. Wrapping the same error
+// twice gives "lock failed: lock failed: ..." and the same "Tip:" line can
+// come from several layers; each shows once.
+func errorMessage(err error) string {
+	seen := map[string]bool{}
+	var lines []string
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = dedupeSegments(line)
+		key := strings.TrimSpace(line)
+		if key != "" && seen[key] {
+			continue
+		}
+		seen[key] = true
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// dedupeSegments drops a ": "-separated part of line that repeats the one
+// before it
+func dedupeSegments(line string) string {
+	parts := strings.Split(line, ": ")
+	kept := parts[:1]
+	for _, part := range parts[1:] {
+		if part != kept[len(kept)-1] {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, ": ")
+}
+
+// errorChainLines lists err and each error it wraps (errors.Unwrap) with
+// its type. A layer only shows the part of its message its cause didn't
+// add, on one line.
+func errorChainLines(err error) []string {
+	lines := []string{"Error chain:"}
+	for depth := 1; err != nil; depth++ {
+		cause := errors.Unwrap(err)
+		msg := err.Error()
+		if cause != nil {
+			if own := strings.TrimSuffix(msg, cause.Error()); own != msg {
+				msg = strings.TrimRight(own, ": \n")
+			}
+		}
+		msg, _, _ = strings.Cut(msg, "\n")
+		if msg == "" {
+			msg = "(wraps the next error)"
+		}
+		lines = append(lines, fmt.Sprintf("  %d. %s (%T)", depth, msg, err))
+		err = cause
+	}
+	return lines
+}
@@ -0,0 +1,72 @@
+// flags.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	quietFlag      bool
+	verboseFlag    int
+	logFormatFlag  string
+	jsonErrorsFlag bool
+	asciiFlag      bool
+	summaryFlag    bool
+	nonInteractive bool
+	assumeYes      bool
+	debugFlag      bool
+)
+
+// AddPersistentFlags registers the global --quiet/--verbose/--log-format/--json-errors/--ascii/--summary,
+// --non-interactive/--assume-yes and --debug flags on the root command.
+// -v sets Verbose, -vv sets Debug.
+func AddPersistentFlags(root *cobra.Command) {
+	root.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Only print warnings and errors")
+	root.PersistentFlags().CountVarP(&verboseFlag, "verbose", "v", "Print more detail (-vv for debug output)")
+	root.PersistentFlags().StringVar(&logFormatFlag, "log-format", string(FormatText), "Message format (text or json)")
+	root.PersistentFlags().BoolVar(&jsonErrorsFlag, "json-errors", false, "Print fatal errors as a JSON object on stderr")
+	root.PersistentFlags().BoolVar(&asciiFlag, "ascii", false, "Draw prompts and tables with ASCII instead of emoji")
+	root.PersistentFlags().BoolVar(&summaryFlag, "summary", false, "Print each step's status and duration at the end of multi-step commands")
+	root.PersistentFlags().BoolVar(&nonInteractive, "non-interactive", false, "Never prompt: use the values from flags and environment variables, failing if one is missing")
+	root.PersistentFlags().BoolVar(&assumeYes, "assume-yes", false, "Answer yes to every confirmation (implies --non-interactive)")
+	root.PersistentFlags().BoolVar(&debugFlag, "debug", false, "On failure, also print the chain of wrapped errors")
+
+	cobra.OnInitialize(applyFlags)
+}
+
+// NonInteractive reports whether prompts must not run (--non-interactive or --assume-yes)
+func NonInteractive() bool {
+	return nonInteractive || assumeYes
+}
+
+// AssumeYes reports whether confirmations are answered yes (--assume-yes)
+func AssumeYes() bool {
+	return assumeYes
+}
+
+// applyFlags runs after flag parsing, before the command runs
+func applyFlags() {
+	SetJSONErrors(jsonErrorsFlag)
+	SetErrorChain(debugFlag)
+	if asciiFlag {
+		SetIcons(ASCIIIcons())
+	}
+
+	switch Format(logFormatFlag) {
+	case FormatText, FormatJSON:
+		SetFormat(Format(logFormatFlag))
+	default:
+		ExitIfError(fmt.Errorf("invalid --log-format %q, must be text or json", logFormatFlag))
+	}
+
+	switch {
+	case quietFlag:
+		SetLevel(Quiet)
+	case verboseFlag >= 2:
+		SetLevel(Debug)
+	case verboseFlag == 1:
+		SetLevel(Verbose)
+	}
+}
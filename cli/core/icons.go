@@ -0,0 +1,111 @@
+// icons.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"os"
+	"sync"
+)
+
+// Icons are the glyphs the prompts and tables draw. Callers put a space
+// after each; the emoji that terminals draw a cell wider than they measure
+// carry an extra one already.
+type Icons struct {
+	Text        string // Text input heading
+	Select      string // Single select heading
+	MultiSelect string // Multi-select heading
+	Edit        string // Name input and $EDITOR heading
+	Form        string // Form heading
+	Question    string // Yes/no question
+	Help        string // Key help overlay heading
+
+	Error   string
+	Warning string
+	Success string
+	Refresh string // Refreshing and loading rows
+
+	Cursor    string // Row under the cursor in lists
+	Focus     string // Focused table column header
+	Checked   string // Multi-select checkbox
+	Unchecked string
+	Mark      string // Marked table row
+	Expanded  string // Expanded list entry
+	Collapsed string
+	Disabled  string // Select option that can't be picked
+}
+
+// DefaultIcons uses emoji and unicode symbols
+func DefaultIcons() Icons {
+	return Icons{
+		Text:        "📝",
+		Select:      "🔹",
+		MultiSelect: "📋",
+		Edit:        "✏️ ",
+		Form:        "🧾",
+		Question:    "❓",
+		Help:        "❔",
+		Error:       "✗",
+		Warning:     "⚠️ ",
+		Success:     "✓",
+		Refresh:     "⟳",
+		Cursor:      "▶",
+		Focus:       "▸",
+		Checked:     "☑",
+		Unchecked:   "☐",
+		Mark:        "✓",
+		Expanded:    "▾",
+		Collapsed:   "▸",
+		Disabled:    "⊘",
+	}
+}
+
+// ASCIIIcons is for terminals and fonts without emoji, or --ascii
+func ASCIIIcons() Icons {
+	return Icons{
+		Text:        ">",
+		Select:      ">",
+		MultiSelect: ">",
+		Edit:        ">",
+		Form:        ">",
+		Question:    "?",
+		Help:        "?",
+		Error:       "x",
+		Warning:     "!",
+		Success:     "*",
+		Refresh:     "~",
+		Cursor:      ">",
+		Focus:       ">",
+		Checked:     "[x]",
+		Unchecked:   "[ ]",
+		Mark:        "*",
+		Expanded:    "v",
+		Collapsed:   ">",
+		Disabled:    "-",
+	}
+}
+
+var (
+	iconsMu sync.RWMutex
+	icons   = initialIcons()
+)
+
+// initialIcons is ASCII under NO_COLOR or TERM=dumb, emoji otherwise
+func initialIcons() Icons {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return ASCIIIcons()
+	}
+	return DefaultIcons()
+}
+
+// SetIcons replaces the icons every prompt and table draws
+func SetIcons(i Icons) {
+	iconsMu.Lock()
+	defer iconsMu.Unlock()
+	icons = i
+}
+
+// CurrentIcons returns the icons in use
+func CurrentIcons() Icons {
+	iconsMu.RLock()
+	defer iconsMu.RUnlock()
+	return icons
+}
@@ -0,0 +1,53 @@
+// interrupt.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+)
+
+// ErrInterrupted is returned by the TUIs (prompts, tables) when the process
+// gets SIGINT or SIGTERM while one is running. ExitIfError exits with 130 for it.
+var ErrInterrupted = errors.New("interrupted")
+
+// interruptExitCode is the shell convention for a process ended by SIGINT
+const interruptExitCode = 130
+
+// CatchInterrupt calls stop once if SIGINT or SIGTERM arrives before release
+// is called, and interrupted reports whether it did. The TUIs use it in place
+// of bubbletea's own handler so an interrupted program quits through its
+// normal shutdown, which leaves raw mode and the alt screen:
+//
+//	interrupted, release := core.CatchInterrupt(p.Quit)
+//	_, err := p.Run()
+//	release()
+//	if interrupted() {
+//		return core.ErrInterrupted
+//	}
+func CatchInterrupt(stop func()) (interrupted func() bool, release func()) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+
+	var caught atomic.Bool
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-sigs:
+			caught.Store(true)
+			stop()
+		case <-done:
+		}
+	}()
+
+	var once atomic.Bool
+	release = func() {
+		if once.CompareAndSwap(false, true) {
+			signal.Stop(sigs)
+			close(done)
+		}
+	}
+	return caught.Load, release
+}
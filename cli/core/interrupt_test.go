@@ -0,0 +1,109 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fakeProgram stands in for a tea.Program: Run blocks until Quit, then runs
+// the program's cleanup the way bubbletea's shutdown restores the terminal
+type fakeProgram struct {
+	quit    chan struct{}
+	cleaned bool
+}
+
+func (p *fakeProgram) Quit() { close(p.quit) }
+
+func (p *fakeProgram) Run() error {
+	defer func() { p.cleaned = true }()
+	select {
+	case <-p.quit:
+	case <-time.After(5 * time.Second):
+		return errors.New("never quit")
+	}
+	return nil
+}
+
+// runInterruptible runs p the way the TUIs do (see CatchInterrupt)
+func runInterruptible(p *fakeProgram, started chan<- struct{}) error {
+	interrupted, release := CatchInterrupt(p.Quit)
+	close(started)
+	err := p.Run()
+	release()
+	if interrupted() {
+		return ErrInterrupted
+	}
+	return err
+}
+
+// guardSignals keeps a stray SIGINT from killing the test binary once
+// CatchInterrupt has released its handler
+func guardSignals(t *testing.T) chan os.Signal {
+	t.Helper()
+	guard := make(chan os.Signal, 4)
+	signal.Notify(guard, os.Interrupt)
+	t.Cleanup(func() { signal.Stop(guard) })
+	return guard
+}
+
+// waitSignal waits until the guard channel has seen the signal
+func waitSignal(t *testing.T, guard chan os.Signal) {
+	t.Helper()
+	select {
+	case <-guard:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SIGINT never arrived")
+	}
+}
+
+func TestCatchInterrupt(t *testing.T) {
+	guard := guardSignals(t)
+	p := &fakeProgram{quit: make(chan struct{})}
+	started := make(chan struct{})
+	errc := make(chan error, 1)
+	go func() { errc <- runInterruptible(p, started) }()
+
+	<-started
+	if err := syscall.Kill(os.Getpid(), syscall.SIGINT); err != nil {
+		t.Fatal(err)
+	}
+	waitSignal(t, guard)
+
+	select {
+	case err := <-errc:
+		if !errors.Is(err, ErrInterrupted) {
+			t.Errorf("run returned %v, want ErrInterrupted", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the program wasn't stopped")
+	}
+	if !p.cleaned {
+		t.Error("the program's cleanup didn't run")
+	}
+}
+
+func TestCatchInterruptRelease(t *testing.T) {
+	guard := guardSignals(t)
+	stops := make(chan struct{}, 2)
+	interrupted, release := CatchInterrupt(func() { stops <- struct{}{} })
+	release()
+	release() // a second release is a no-op
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGINT); err != nil {
+		t.Fatal(err)
+	}
+	waitSignal(t, guard)
+
+	select {
+	case <-stops:
+		t.Error("stop was called after release")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if interrupted() {
+		t.Error("interrupted() = true after release, want false")
+	}
+}
@@ -0,0 +1,354 @@
+// messages.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Where messages are written. Swap these out with SetOutput to capture
+// messages in tests or route them somewhere else.
+var (
+	stdout io.Writer = os.Stdout
+	stderr io.Writer = os.Stderr
+)
+
+// Level controls which messages are printed
+type Level int
+
+const (
+	Quiet   Level = iota // only warnings and errors
+	Normal               // default
+	Verbose              // adds VerboseMsg
+	Debug                // adds DebugMsg
+)
+
+var level = Normal
+
+// SetLevel sets the global message verbosity
+func SetLevel(l Level) {
+	level = l
+}
+
+// GetLevel returns the current message verbosity
+func GetLevel() Level {
+	return level
+}
+
+// Format controls how messages are written
+type Format string
+
+const (
+	FormatText Format = "text" // colorized, human readable (default)
+	FormatJSON Format = "json" // one JSON object per line
+)
+
+var format = FormatText
+
+// SetFormat switches between human text and JSON lines
+func SetFormat(f Format) {
+	format = f
+}
+
+// GetFormat returns the current message format
+func GetFormat() Format {
+	return format
+}
+
+// jsonMessage is the shape of a message in FormatJSON
+type jsonMessage struct {
+	Level string `json:"level"`
+	Msg   string `json:"msg"`
+	Ts    string `json:"ts"`
+}
+
+// Message styles
+var (
+	okayStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("42")).
+			Bold(true)
+
+	warnStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214"))
+
+	debugStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241"))
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Bold(true)
+)
+
+// SetOutput redirects messages. A nil writer resets it to os.Stdout/os.Stderr.
+func SetOutput(out, errOut io.Writer) {
+	if out == nil {
+		out = os.Stdout
+	}
+	if errOut == nil {
+		errOut = os.Stderr
+	}
+	stdout = out
+	stderr = errOut
+}
+
+// StdMsg prints a plain message to stdout
+func StdMsg(msg string) {
+	if level < Normal {
+		return
+	}
+	emit(stdout, "info", lipgloss.NewStyle(), msg)
+}
+
+// OkayMsg prints a success message to stdout
+func OkayMsg(msg string) {
+	if level < Normal {
+		return
+	}
+	emit(stdout, "okay", okayStyle, msg)
+}
+
+// VerboseMsg prints extra detail to stdout, only with --verbose
+func VerboseMsg(msg string) {
+	if level < Verbose {
+		return
+	}
+	emit(stdout, "verbose", lipgloss.NewStyle(), msg)
+}
+
+// DebugMsg prints a DEBUG line to stderr, only with -vv
+func DebugMsg(msg string) {
+	if level < Debug {
+		return
+	}
+	if format == FormatJSON {
+		emit(stderr, "debug", debugStyle, msg)
+		return
+	}
+	emit(stderr, "debug", debugStyle, "DEBUG: "+msg)
+}
+
+// WarnMsg prints a warning to stderr
+func WarnMsg(msg string) {
+	emit(stderr, "warn", warnStyle, msg)
+}
+
+// ErrorMsg prints an error to stderr
+func ErrorMsg(msg string) {
+	emit(stderr, "error", errorStyle, msg)
+}
+
+// PlainMsg prints msg to stdout exactly as given, unstyled and also with
+// --quiet, for output meant to be copied or redirected (e.g. the commands of
+// lock --dry-run=script). With FormatJSON it's an "info" message.
+func PlainMsg(msg string) {
+	if format == FormatJSON {
+		emit(stdout, "info", lipgloss.NewStyle(), msg)
+		return
+	}
+	writeLine(stdout, msg)
+}
+
+// RecordMsg prints v as a single JSON object on its own line to stdout, for
+// structured output with FormatJSON (e.g. one record per lock --dry-run step)
+func RecordMsg(v any) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	writeLine(stdout, string(line))
+	return nil
+}
+
+// writeLine writes line to w as is, on a clean line
+func writeLine(w io.Writer, line string) {
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	if statusLineActive {
+		clearLine()
+	}
+	fmt.Fprintln(w, line)
+}
+
+// emit writes a single message in the current format.
+// JSON output is never styled so it stays machine-readable.
+func emit(w io.Writer, levelName string, style lipgloss.Style, msg string) {
+	outputMu.Lock()
+	defer outputMu.Unlock()
+
+	// Print on a clean line, the spinner/progress bar redraws itself on its next update
+	if statusLineActive {
+		clearLine()
+	}
+
+	if format == FormatJSON {
+		line, _ := json.Marshal(jsonMessage{
+			Level: levelName,
+			Msg:   msg,
+			Ts:    time.Now().UTC().Format(time.RFC3339),
+		})
+		fmt.Fprintln(w, string(line))
+		return
+	}
+	fmt.Fprintln(w, style.Render(msg))
+}
+
+// jsonErrorMessage is the shape of a fatal error with JSON errors on
+type jsonErrorMessage struct {
+	Error jsonErrorBody `json:"error"`
+}
+
+type jsonErrorBody struct {
+	Stage   string `json:"stage,omitempty"`
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+var (
+	jsonErrors bool
+	stage      string
+)
+
+// SetJSONErrors makes fatal errors print as a single JSON object on stderr.
+// This is always on with FormatJSON.
+func SetJSONErrors(on bool) {
+	jsonErrors = on
+}
+
+// SetStage names the step the command is in (e.g. "lock"), reported with JSON errors
+func SetStage(s string) {
+	stage = s
+}
+
+// stager is implemented by errors that know which step failed, e.g. terraform.ToolError
+type stager interface {
+	ErrorStage() string
+}
+
+// exitCoder is implemented by errors that know which exit code the process
+// should use, e.g. terraform.ToolError.
+type exitCoder interface {
+	ExitStatus() int
+}
+
+// cleanup hooks run before exiting, most recently registered first
+var cleanups []func()
+
+// OnExit registers a cleanup function (remove temp files, restore a backup, ...)
+// that runs before ExitIfError/ExitIfErrorCode exit the process.
+func OnExit(fn func()) {
+	cleanups = append(cleanups, fn)
+}
+
+// ExitIfError prints the error and exits. The exit code comes from the error if it
+// carries one, is 130 for ErrInterrupted and otherwise 1. Errors from
+// errors.Join are printed one by one, like ExitIfErrors. Repeated parts of the
+// message are printed once, and --debug adds the chain of wrapped errors.
+func ExitIfError(err error) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		ExitIfErrors(joined.Unwrap())
+		return
+	}
+	code := 1
+	var coder exitCoder
+	if errors.As(err, &coder) && coder.ExitStatus() > 0 {
+		code = coder.ExitStatus()
+	} else if errors.Is(err, ErrInterrupted) {
+		code = interruptExitCode
+	}
+	ExitIfErrorCode(err, code)
+}
+
+// ExitIfErrorCode prints the error and exits with the given code
+func ExitIfErrorCode(err error, code int) {
+	if err == nil {
+		return
+	}
+	if jsonErrors || format == FormatJSON {
+		emitJSONError(err, code)
+	} else {
+		ErrorMsg(formatError(err))
+	}
+	exit(code)
+}
+
+// ExitIfErrors prints every non-nil error and exits once, e.g. after
+// validating all flags or with the failed pages of --keep-going. The exit code
+// is the first one carried by an error, otherwise 1. Does nothing if all are nil.
+func ExitIfErrors(errs []error) {
+	errs = flattenErrors(errs)
+	if len(errs) == 0 {
+		return
+	}
+
+	code := 1
+	for _, err := range errs {
+		var coder exitCoder
+		if errors.As(err, &coder) && coder.ExitStatus() > 0 {
+			code = coder.ExitStatus()
+			break
+		}
+	}
+
+	for _, err := range errs {
+		if jsonErrors || format == FormatJSON {
+			emitJSONError(err, code)
+		} else {
+			ErrorMsg(formatError(err))
+		}
+	}
+	exit(code)
+}
+
+// flattenErrors drops nils and expands errors.Join into its errors
+func flattenErrors(errs []error) []error {
+	var flat []error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			flat = append(flat, flattenErrors(joined.Unwrap())...)
+			continue
+		}
+		flat = append(flat, err)
+	}
+	return flat
+}
+
+// emitJSONError writes {"error":{"stage":...,"message":...,"code":...}} to stderr
+func emitJSONError(err error, code int) {
+	body := jsonErrorBody{
+		Stage:   stage,
+		Message: errorMessage(err),
+		Code:    code,
+	}
+	var s stager
+	if errors.As(err, &s) {
+		body.Stage = s.ErrorStage()
+	}
+
+	line, _ := json.Marshal(jsonErrorMessage{Error: body})
+
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	if statusLineActive {
+		clearLine()
+	}
+	fmt.Fprintln(stderr, string(line))
+}
+
+func exit(code int) {
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,96 @@
+// paginator.go - Put this in pkg/core/ folder
+package core
+
+// Paginator does the page math for anything shown a page at a time, the
+// table's rows or a long select list. The zero value puts everything on
+// one page.
+type Paginator struct {
+	Page     int  // Current page, from 0
+	PageSize int  // Items per page, 0 puts everything on one page
+	Total    int  // Number of items
+	Open     bool // More items may follow Total (lazy loading), so Next isn't capped at the last page
+}
+
+// NewPaginator starts on the first page
+func NewPaginator(pageSize, total int) Paginator {
+	return Paginator{PageSize: pageSize, Total: total}
+}
+
+// TotalPages is the number of pages, at least 1 even when there are no items
+func (p Paginator) TotalPages() int {
+	if p.PageSize <= 0 || p.Total <= 0 {
+		return 1
+	}
+	return (p.Total + p.PageSize - 1) / p.PageSize
+}
+
+// Offset is the index of the first item on the current page
+func (p Paginator) Offset() int {
+	if p.PageSize <= 0 {
+		return 0
+	}
+	return p.Page * p.PageSize
+}
+
+// Bounds returns the current page as a slice range [start:end] into n
+// items; start == end past the last item
+func (p Paginator) Bounds(n int) (start, end int) {
+	if p.PageSize <= 0 {
+		return 0, n
+	}
+	start = p.Page * p.PageSize
+	if start > n {
+		start = n
+	}
+	end = start + p.PageSize
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// PageItems returns the items on p's current page, empty past the last one
+func PageItems[T any](p Paginator, items []T) []T {
+	start, end := p.Bounds(len(items))
+	return items[start:end]
+}
+
+// HasNext reports whether there's a page after the current one
+func (p Paginator) HasNext() bool {
+	return p.Open || (p.PageSize > 0 && (p.Page+1)*p.PageSize < p.Total)
+}
+
+// HasPrev reports whether there's a page before the current one
+func (p Paginator) HasPrev() bool {
+	return p.Page > 0
+}
+
+// Next moves to the next page, reporting whether there was one
+func (p *Paginator) Next() bool {
+	if !p.HasNext() {
+		return false
+	}
+	p.Page++
+	return true
+}
+
+// Prev moves to the previous page, reporting whether there was one
+func (p *Paginator) Prev() bool {
+	if !p.HasPrev() {
+		return false
+	}
+	p.Page--
+	return true
+}
+
+// Goto moves to page, clamped to the existing pages (only to the first one
+// while Open)
+func (p *Paginator) Goto(page int) {
+	if last := p.TotalPages() - 1; page > last && !p.Open {
+		page = last
+	}
+	if page < 0 {
+		page = 0
+	}
+	p.Page = page
+}
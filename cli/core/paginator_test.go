@@ -0,0 +1,125 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPaginatorTotalPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		pageSize int
+		total    int
+		want     int
+	}{
+		{"zero rows", 10, 0, 1},
+		{"exactly one page", 10, 10, 1},
+		{"partial last page", 10, 25, 3},
+		{"exactly two pages", 10, 20, 2},
+		{"no page size", 0, 25, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPaginator(tt.pageSize, tt.total).TotalPages(); got != tt.want {
+				t.Errorf("TotalPages() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginatorBounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		p          Paginator
+		n          int
+		start, end int
+	}{
+		{"zero rows", Paginator{PageSize: 10}, 0, 0, 0},
+		{"exactly one page", Paginator{PageSize: 10}, 10, 0, 10},
+		{"full middle page", Paginator{Page: 1, PageSize: 10}, 25, 10, 20},
+		{"partial last page", Paginator{Page: 2, PageSize: 10}, 25, 20, 25},
+		{"past the last page", Paginator{Page: 5, PageSize: 10}, 25, 25, 25},
+		{"no page size", Paginator{PageSize: 0}, 25, 0, 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.p.Bounds(tt.n)
+			if start != tt.start || end != tt.end {
+				t.Errorf("Bounds(%d) = %d, %d, want %d, %d", tt.n, start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestPageItems(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	p := Paginator{Page: 1, PageSize: 2, Total: len(items)}
+	if got, want := PageItems(p, items), []string{"c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PageItems() = %v, want %v", got, want)
+	}
+	p.Page = 3
+	if got := PageItems(p, items); len(got) != 0 {
+		t.Errorf("PageItems() past the last page = %v, want none", got)
+	}
+}
+
+func TestPaginatorNextPrev(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        Paginator
+		next     bool
+		prev     bool
+		nextPage int // Page after Next
+		prevPage int // Page after Prev
+	}{
+		{"zero rows", Paginator{PageSize: 10}, false, false, 0, 0},
+		{"exactly one page", Paginator{PageSize: 10, Total: 10}, false, false, 0, 0},
+		{"first of several", Paginator{PageSize: 10, Total: 25}, true, false, 1, 0},
+		{"partial last page", Paginator{Page: 2, PageSize: 10, Total: 25}, false, true, 2, 1},
+		{"open past the total", Paginator{Page: 2, PageSize: 10, Total: 25, Open: true}, true, true, 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.HasNext(); got != tt.next {
+				t.Errorf("HasNext() = %v, want %v", got, tt.next)
+			}
+			if got := tt.p.HasPrev(); got != tt.prev {
+				t.Errorf("HasPrev() = %v, want %v", got, tt.prev)
+			}
+
+			next := tt.p
+			if moved := next.Next(); moved != tt.next || next.Page != tt.nextPage {
+				t.Errorf("Next() = %v on page %d, want %v on page %d", moved, next.Page, tt.next, tt.nextPage)
+			}
+			prev := tt.p
+			if moved := prev.Prev(); moved != tt.prev || prev.Page != tt.prevPage {
+				t.Errorf("Prev() = %v on page %d, want %v on page %d", moved, prev.Page, tt.prev, tt.prevPage)
+			}
+		})
+	}
+}
+
+func TestPaginatorGoto(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Paginator
+		page int
+		want int
+	}{
+		{"in range", Paginator{PageSize: 10, Total: 25}, 1, 1},
+		{"last page", Paginator{PageSize: 10, Total: 25}, 2, 2},
+		{"past the last page", Paginator{PageSize: 10, Total: 25}, 7, 2},
+		{"negative", Paginator{Page: 1, PageSize: 10, Total: 25}, -3, 0},
+		{"zero rows", Paginator{PageSize: 10}, 4, 0},
+		{"open past the total", Paginator{PageSize: 10, Total: 25, Open: true}, 7, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.p
+			p.Goto(tt.page)
+			if p.Page != tt.want {
+				t.Errorf("Goto(%d) = page %d, want %d", tt.page, p.Page, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// progress.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/progress"
+	"golang.org/x/term"
+)
+
+// Progress is a progress bar drawn on stderr. Create it with ProgressBar.
+type Progress struct {
+	bar         progress.Model
+	total       int
+	current     int
+	enabled     bool
+	stopSpinner func()
+}
+
+// ProgressBar starts a progress bar for total items. When total is unknown (<= 0)
+// it shows a spinner instead. Like Spinner, it does nothing when stdout isn't a
+// terminal, in quiet mode, or with JSON output.
+func ProgressBar(total int) *Progress {
+	p := &Progress{total: total}
+	if level < Normal || format == FormatJSON || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return p
+	}
+
+	if total <= 0 {
+		p.stopSpinner = Spinner("Loading...")
+		return p
+	}
+
+	p.enabled = true
+	p.bar = progress.New(progress.WithDefaultGradient(), progress.WithWidth(40))
+
+	outputMu.Lock()
+	statusLineActive = true
+	outputMu.Unlock()
+
+	p.draw()
+	return p
+}
+
+// Increment moves the bar forward by one item
+func (p *Progress) Increment() {
+	p.Add(1)
+}
+
+// Add moves the bar forward by n items
+func (p *Progress) Add(n int) {
+	p.current += n
+	if p.current > p.total {
+		p.current = p.total
+	}
+	p.draw()
+}
+
+// SetPercent sets the bar directly, pct is between 0 and 1
+func (p *Progress) SetPercent(pct float64) {
+	p.current = int(pct * float64(p.total))
+	p.draw()
+}
+
+// Done removes the bar (or spinner) from the terminal. Safe to call on a nil *Progress.
+func (p *Progress) Done() {
+	if p == nil {
+		return
+	}
+	if p.stopSpinner != nil {
+		p.stopSpinner()
+		return
+	}
+	if !p.enabled {
+		return
+	}
+	outputMu.Lock()
+	statusLineActive = false
+	clearLine()
+	outputMu.Unlock()
+	p.enabled = false
+}
+
+func (p *Progress) draw() {
+	if !p.enabled {
+		return
+	}
+	pct := float64(p.current) / float64(p.total)
+
+	outputMu.Lock()
+	fmt.Fprintf(stderr, "\r%s %d/%d", p.bar.ViewAs(pct), p.current, p.total)
+	outputMu.Unlock()
+}
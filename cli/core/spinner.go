@@ -0,0 +1,72 @@
+// spinner.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// outputMu keeps messages and the spinner/progress bar from writing over each other.
+// statusLineActive is set while one of them owns the current stderr line.
+var (
+	outputMu         sync.Mutex
+	statusLineActive bool
+)
+
+// Spinner shows an animated indicator on stderr until stop is called.
+// It does nothing when stdout isn't a terminal, in quiet mode, or with JSON output.
+func Spinner(label string) (stop func()) {
+	if level < Normal || format == FormatJSON || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	outputMu.Lock()
+	statusLineActive = true
+	outputMu.Unlock()
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+
+		for frame := 0; ; frame++ {
+			outputMu.Lock()
+			fmt.Fprintf(stderr, "\r%s %s", debugStyle.Render(spinnerFrames[frame%len(spinnerFrames)]), label)
+			outputMu.Unlock()
+
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			wg.Wait()
+
+			outputMu.Lock()
+			statusLineActive = false
+			clearLine()
+			outputMu.Unlock()
+		})
+	}
+}
+
+// clearLine wipes the spinner's line; callers must hold outputMu
+func clearLine() {
+	fmt.Fprint(stderr, "\r\033[K")
+}
@@ -0,0 +1,79 @@
+// summary.go - Put this in pkg/core/ folder
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+// Step statuses in a Summary
+const (
+	StepOK      = "ok"
+	StepFailed  = "failed"
+	StepSkipped = "skipped"
+)
+
+// SummaryStep is one step of a multi-step command
+type SummaryStep struct {
+	Name     string
+	Status   string // StepOK, StepFailed or StepSkipped
+	Duration time.Duration
+	Error    error // Set when the step failed
+}
+
+// Summary collects the steps of a multi-step command (e.g. lock's remove,
+// init, generate, init), so --summary can show at the end which step failed
+// or was slow. Print it with table.PrintSummary.
+type Summary struct {
+	mu    sync.Mutex
+	steps []SummaryStep
+}
+
+// NewSummary returns an empty summary
+func NewSummary() *Summary {
+	return &Summary{}
+}
+
+// Run times step and records it under name, returning its error
+func (s *Summary) Run(name string, step func() error) error {
+	start := time.Now()
+	err := step()
+	status := StepOK
+	if err != nil {
+		status = StepFailed
+	}
+	s.add(SummaryStep{Name: name, Status: status, Duration: time.Since(start), Error: err})
+	return err
+}
+
+// Skip records a step that didn't run
+func (s *Summary) Skip(name string) {
+	s.add(SummaryStep{Name: name, Status: StepSkipped})
+}
+
+func (s *Summary) add(step SummaryStep) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.steps = append(s.steps, step)
+}
+
+// Steps returns the recorded steps in the order they ran
+func (s *Summary) Steps() []SummaryStep {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SummaryStep(nil), s.steps...)
+}
+
+// Total is the time spent in all steps
+func (s *Summary) Total() time.Duration {
+	var total time.Duration
+	for _, step := range s.Steps() {
+		total += step.Duration
+	}
+	return total
+}
+
+// SummaryEnabled reports whether --summary was passed
+func SummaryEnabled() bool {
+	return summaryFlag
+}
@@ -0,0 +1,158 @@
+// list.go - Put this in pkg/list/ folder
+
+// Package list provides a scrollable, filterable single-column picker, a
+// lighter alternative to the table for long lists of plain choices
+package list
+
+import (
+	"errors"
+	"os"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/term"
+
+	"sfgitlab.opr.statefarm.org/sf/statefarm/pkg/core"
+)
+
+// ErrCancelled is returned by ShowList when the user backs out (esc/q/ctrl+c)
+var ErrCancelled = errors.New("cancelled")
+
+// ErrInterrupted is returned by ShowList when the process gets SIGINT or
+// SIGTERM, after the terminal has been restored
+var ErrInterrupted = core.ErrInterrupted
+
+// Space taken by the box around the list
+const (
+	boxWidth  = 4 // border and PaddingX
+	boxHeight = 2 // border
+)
+
+// item is a choice, remembering its index so filtering doesn't lose it
+type item struct {
+	text  string
+	index int
+}
+
+func (i item) FilterValue() string { return i.text }
+func (i item) Title() string       { return i.text }
+func (i item) Description() string { return "" }
+
+// listModel wraps bubbles/list with the prompts' colors and a border
+type listModel struct {
+	list      list.Model
+	chosen    int
+	cancelled bool
+}
+
+func newListModel(title string, items []string) listModel {
+	listItems := make([]list.Item, len(items))
+	for i, text := range items {
+		listItems[i] = item{text: text, index: i}
+	}
+
+	delegate := list.NewDefaultDelegate()
+	delegate.ShowDescription = false
+	delegate.SetSpacing(0)
+	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
+		Foreground(lipgloss.Color("86")).
+		BorderForeground(lipgloss.Color("86")).
+		Bold(true)
+	delegate.Styles.NormalTitle = delegate.Styles.NormalTitle.
+		Foreground(lipgloss.Color("252"))
+
+	l := list.New(listItems, delegate, 80, 20)
+	l.Title = title
+	l.Styles.Title = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("229")).
+		Background(core.BoxAccentColor).
+		Padding(0, 1).
+		Bold(true)
+	l.SetShowStatusBar(true)
+	l.SetFilteringEnabled(true)
+	// Quitting is handled by listModel, so it can tell cancel from pick
+	l.DisableQuitKeybindings()
+
+	return listModel{list: l, chosen: -1}
+}
+
+func (m listModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetSize(msg.Width-boxWidth, msg.Height-boxHeight)
+		return m, nil
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.cancelled = true
+			return m, tea.Quit
+		}
+		// While typing a filter, enter and esc belong to the filter input
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+		switch msg.String() {
+		case "enter":
+			if selected, ok := m.list.SelectedItem().(item); ok {
+				m.chosen = selected.index
+				return m, tea.Quit
+			}
+			return m, nil
+		case "esc":
+			// The first esc clears an applied filter, the next one cancels
+			if m.list.FilterState() == list.FilterApplied {
+				m.list.ResetFilter()
+				return m, nil
+			}
+			m.cancelled = true
+			return m, tea.Quit
+		case "q":
+			m.cancelled = true
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m listModel) View() string {
+	if m.chosen >= 0 || m.cancelled {
+		return ""
+	}
+	return core.Box(m.list.View(), core.BoxOptions{PaddingX: 1})
+}
+
+// ShowList shows items in a scrollable list, '/' filters it, and returns the
+// index of the picked item. The list is drawn on stderr when stdout is piped.
+func ShowList(title string, items []string) (int, error) {
+	opts := []tea.ProgramOption{tea.WithAltScreen(), tea.WithoutSignalHandler()}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		opts = append(opts, tea.WithInputTTY())
+	}
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		opts = append(opts, tea.WithOutput(os.Stderr))
+	}
+
+	p := tea.NewProgram(newListModel(title, items), opts...)
+	interrupted, release := core.CatchInterrupt(p.Quit)
+	finalModel, err := p.Run()
+	release()
+	if interrupted() {
+		return -1, ErrInterrupted
+	}
+	if err != nil {
+		return -1, err
+	}
+
+	m := finalModel.(listModel)
+	if m.cancelled || m.chosen < 0 {
+		return -1, ErrCancelled
+	}
+	return m.chosen, nil
+}
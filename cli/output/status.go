@@ -0,0 +1,86 @@
+// status.go - Put this in pkg/output/ folder
+package output
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Status colors
+var (
+	StatusGreen  = lipgloss.Color("42")
+	StatusYellow = lipgloss.Color("214")
+	StatusRed    = lipgloss.Color("196")
+)
+
+// defaultStatusColors is the vocabulary colored until SetStatusColors replaces it
+func defaultStatusColors() map[string]lipgloss.Color {
+	return map[string]lipgloss.Color{
+		"active":  StatusGreen,
+		"healthy": StatusGreen,
+		"running": StatusGreen,
+		"warning": StatusYellow,
+		"idle":    StatusYellow,
+		"pending": StatusYellow,
+		"down":    StatusRed,
+		"stopped": StatusRed,
+		"failed":  StatusRed,
+	}
+}
+
+var (
+	statusMu     sync.RWMutex
+	statusColors = defaultStatusColors()
+)
+
+// SetStatusColors replaces the token -> color map used to color status cells
+// in table output. Tokens match whole cell values, ignoring case and
+// surrounding whitespace. A nil map turns status coloring off.
+func SetStatusColors(colors map[string]lipgloss.Color) {
+	normalized := make(map[string]lipgloss.Color, len(colors))
+	for token, color := range colors {
+		normalized[strings.ToLower(strings.TrimSpace(token))] = color
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	statusColors = normalized
+}
+
+// ResetStatusColors goes back to the built-in Active/Warning/Down vocabulary
+func ResetStatusColors() {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	statusColors = defaultStatusColors()
+}
+
+// ColorizeStatus colors value if it's a known status token. Anything else,
+// and everything under NO_COLOR, is returned unchanged. Only call this for
+// TypeTable output, JSON and YAML must stay plain.
+func ColorizeStatus(value string) string {
+	if os.Getenv("NO_COLOR") != "" {
+		return value
+	}
+
+	statusMu.RLock()
+	color, ok := statusColors[strings.ToLower(strings.TrimSpace(value))]
+	statusMu.RUnlock()
+	if !ok {
+		return value
+	}
+	return lipgloss.NewStyle().Foreground(color).Render(value)
+}
+
+// ColorizeStatusRow returns a copy of cells with status tokens colored,
+// for the table printer to use row by row. Pad cells to their column width
+// first, the color codes would otherwise throw off tabwriter alignment.
+func ColorizeStatusRow(cells []string) []string {
+	colored := make([]string, len(cells))
+	for i, cell := range cells {
+		colored[i] = ColorizeStatus(cell)
+	}
+	return colored
+}
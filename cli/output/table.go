@@ -0,0 +1,54 @@
+// table.go - Put this in pkg/output/ folder
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Space between table columns
+const columnGap = "   "
+
+// PrintTable writes v to w as an aligned text table, with the headers and
+// rows from TableColumns. wide adds the columns tagged wide, for TypeTableWide.
+// Status cells are colored with ColorizeStatus.
+func PrintTable(w io.Writer, v any, wide bool) error {
+	headers, rows, err := TableColumns(v, wide)
+	if err != nil {
+		return err
+	}
+
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = runewidth.StringWidth(header)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], runewidth.StringWidth(cell))
+		}
+	}
+
+	fmt.Fprintln(w, strings.Join(padCells(headers, widths), columnGap))
+	for _, row := range rows {
+		// Padded first, so the color codes don't count towards the widths
+		fmt.Fprintln(w, strings.Join(ColorizeStatusRow(padCells(row, widths)), columnGap))
+	}
+	return nil
+}
+
+// padCells pads each cell to its column width, except the last so lines
+// don't end in spaces
+func padCells(cells []string, widths []int) []string {
+	padded := make([]string, len(cells))
+	for i, cell := range cells {
+		if i == len(cells)-1 {
+			padded[i] = cell
+			continue
+		}
+		padded[i] = runewidth.FillRight(cell, widths[i])
+	}
+	return padded
+}
@@ -0,0 +1,87 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+type tableItem struct {
+	Name      string `table:"NAME"`
+	Status    string `table:"STATUS"`
+	CreatedBy string `table:"CREATED BY,wide"`
+	Internal  string `table:"-"`
+}
+
+var tableItems = []tableItem{
+	{Name: "orders", Status: "Active", CreatedBy: "alice", Internal: "x"},
+	{Name: "billing-api", Status: "Down", CreatedBy: "bob", Internal: "y"},
+}
+
+func TestPrintTable(t *testing.T) {
+	tests := []struct {
+		name string
+		wide bool
+		want string
+	}{
+		{"table", false, "NAME          STATUS\norders        Active\nbilling-api   Down\n"},
+		{"wide", true, "NAME          STATUS   CREATED BY\norders        Active   alice\nbilling-api   Down     bob\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := PrintTable(&buf, tableItems, tt.wide); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("PrintTable() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintTableRejectsNonStructs(t *testing.T) {
+	var buf bytes.Buffer
+	err := PrintTable(&buf, []string{"a"}, false)
+	if err == nil || !strings.Contains(err.Error(), "needs structs") {
+		t.Errorf("PrintTable() = %v, want the needs structs error", err)
+	}
+}
+
+func TestPrintTableColorsStatus(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+	t.Setenv("NO_COLOR", "")
+
+	var buf bytes.Buffer
+	if err := PrintTable(&buf, tableItems, false); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	if strings.Contains(lines[0], "\x1b[") {
+		t.Errorf("header is colored: %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "\x1b[38;5;42m") || !strings.Contains(lines[2], "\x1b[38;5;196m") {
+		t.Errorf("status cells aren't colored:\n%q", buf.String())
+	}
+	if strings.Contains(lines[1], "\x1b[38;5;42morders") {
+		t.Errorf("name cell is colored: %q", lines[1])
+	}
+	if got := ansi.Strip(buf.String()); got != "NAME          STATUS\norders        Active\nbilling-api   Down\n" {
+		t.Errorf("colored table doesn't line up:\n%s", got)
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	buf.Reset()
+	if err := PrintTable(&buf, tableItems, false); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("NO_COLOR table is colored:\n%q", buf.String())
+	}
+}
@@ -0,0 +1,118 @@
+// wide.go - Put this in pkg/output/ folder
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TypeTableWide is the table with the lower-priority columns too, like
+// kubectl's -o wide. Register it next to TypeTable:
+//
+//	flags.output.Bind(cmd, output.TypeJSON, output.TypeYaml, output.TypeTable, output.TypeTableWide)
+const TypeTableWide = "wide"
+
+// Which columns a struct field shows up in is declared with a `table` tag:
+//
+//	type ApplicationServices struct {
+//		Name      string `table:"NAME"`
+//		Status    string `table:"STATUS"`
+//		Cursor    string `table:"CURSOR,wide"`
+//		CreatedBy string `table:"CREATED BY,wide"`
+//		Internal  string `table:"-"`
+//	}
+//
+// Untagged exported fields are normal columns headed by their field name.
+
+// tableColumn is a struct field shown as a table column
+type tableColumn struct {
+	index  []int
+	header string
+}
+
+// tableColumns returns the columns of a struct type, wide ones only when asked
+func tableColumns(t reflect.Type, wide bool) []tableColumn {
+	var columns []tableColumn
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		tag, tagged := field.Tag.Lookup("table")
+		if tag == "-" {
+			continue
+		}
+		header, opts, _ := strings.Cut(tag, ",")
+		if !tagged || header == "" {
+			header = field.Name
+		}
+		if opts == "wide" && !wide {
+			continue
+		}
+		columns = append(columns, tableColumn{index: field.Index, header: header})
+	}
+	return columns
+}
+
+// TableColumns turns a struct, or a slice of structs (or pointers to them),
+// into table headers and rows following the fields' `table` tags. wide adds
+// the columns tagged wide, for TypeTableWide.
+func TableColumns(v any, wide bool) ([]string, [][]string, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if !value.IsValid() {
+		return nil, nil, nil
+	}
+
+	items := []reflect.Value{value}
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		items = make([]reflect.Value, value.Len())
+		for i := range items {
+			items[i] = value.Index(i)
+		}
+	}
+
+	elem := value.Type()
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("table output needs structs, got %s", elem)
+	}
+
+	columns := tableColumns(elem, wide)
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+	}
+
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		item = reflect.Indirect(item)
+		row := make([]string, len(columns))
+		if item.IsValid() {
+			for i, col := range columns {
+				row[i] = cellString(item, col.index)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows, nil
+}
+
+// cellString formats one field, empty for nil pointers along the way
+func cellString(item reflect.Value, index []int) string {
+	field, err := item.FieldByIndexErr(index)
+	if err != nil {
+		return ""
+	}
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+	return fmt.Sprint(field.Interface())
+}
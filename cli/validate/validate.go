@@ -0,0 +1,94 @@
+// validate.go - Put this in pkg/validate/ folder
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Validator checks a value and returns an error saying what's wrong with it
+type Validator func(string) error
+
+// All runs the validators in order and returns the first error, e.g.
+//
+//	validate.All(validate.MinLen(3), validate.MaxLen(63), validate.Lowercase(), validate.NoSpaces())
+func All(validators ...Validator) Validator {
+	return func(value string) error {
+		for _, v := range validators {
+			if err := v(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// MinLen requires at least n characters
+func MinLen(n int) Validator {
+	return func(value string) error {
+		if utf8.RuneCountInString(value) < n {
+			return fmt.Errorf("must be at least %d characters", n)
+		}
+		return nil
+	}
+}
+
+// MaxLen allows at most n characters
+func MaxLen(n int) Validator {
+	return func(value string) error {
+		if count := utf8.RuneCountInString(value); count > n {
+			return fmt.Errorf("must be at most %d characters (got %d)", n, count)
+		}
+		return nil
+	}
+}
+
+// Regex requires the value to match pattern. description says what the
+// pattern allows, for the error message (e.g. "letters, digits and '-'").
+// Panics if pattern doesn't compile, like regexp.MustCompile.
+func Regex(pattern, description string) Validator {
+	re := regexp.MustCompile(pattern)
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return fmt.Errorf("may only contain %s", description)
+		}
+		return nil
+	}
+}
+
+// Lowercase rejects values with uppercase letters
+func Lowercase() Validator {
+	return func(value string) error {
+		for _, r := range value {
+			if unicode.IsUpper(r) {
+				return fmt.Errorf("must be lowercase (found %q)", r)
+			}
+		}
+		return nil
+	}
+}
+
+// NoSpaces rejects values with any whitespace
+func NoSpaces() Validator {
+	return func(value string) error {
+		if strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+			return fmt.Errorf("must not contain spaces")
+		}
+		return nil
+	}
+}
+
+// OneOf requires the value to be one of choices, ignoring case
+func OneOf(choices ...string) Validator {
+	return func(value string) error {
+		for _, c := range choices {
+			if strings.EqualFold(value, c) {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s", strings.Join(choices, ", "))
+	}
+}
@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+// check runs v on each value and fails unless the accepted ones pass and the
+// rejected ones fail with an error containing wantErr
+func check(t *testing.T, v Validator, accepted, rejected []string, wantErr string) {
+	t.Helper()
+	for _, value := range accepted {
+		if err := v(value); err != nil {
+			t.Errorf("%q rejected: %v", value, err)
+		}
+	}
+	for _, value := range rejected {
+		err := v(value)
+		if err == nil {
+			t.Errorf("%q accepted, want an error", value)
+			continue
+		}
+		if !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("%q: error %q, want it to contain %q", value, err, wantErr)
+		}
+	}
+}
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        Validator
+		accepted []string
+		rejected []string
+		wantErr  string
+	}{
+		{
+			name:     "MinLen",
+			v:        MinLen(3),
+			accepted: []string{"abc", "abcd", "äöü"},
+			rejected: []string{"", "ab", "äö"},
+			wantErr:  "at least 3 characters",
+		},
+		{
+			name:     "MaxLen",
+			v:        MaxLen(3),
+			accepted: []string{"", "abc", "äöü"},
+			rejected: []string{"abcd", "äöüß"},
+			wantErr:  "at most 3 characters (got 4)",
+		},
+		{
+			name:     "Regex",
+			v:        Regex(`^[a-z0-9-]+$`, "letters, digits and '-'"),
+			accepted: []string{"my-cache", "cache1"},
+			rejected: []string{"", "my_cache", "my cache"},
+			wantErr:  "may only contain letters, digits and '-'",
+		},
+		{
+			name:     "Lowercase",
+			v:        Lowercase(),
+			accepted: []string{"", "my-cache", "123", "ünïcode"},
+			rejected: []string{"My-cache", "myCache", "Ünicode"},
+			wantErr:  "must be lowercase",
+		},
+		{
+			name:     "NoSpaces",
+			v:        NoSpaces(),
+			accepted: []string{"", "my-cache"},
+			rejected: []string{"my cache", " lead", "tab\there", "line\nbreak"},
+			wantErr:  "must not contain spaces",
+		},
+		{
+			name:     "OneOf",
+			v:        OneOf("test", "prod"),
+			accepted: []string{"test", "prod", "PROD"},
+			rejected: []string{"", "dev", "prod "},
+			wantErr:  "must be one of test, prod",
+		},
+		{
+			name:     "All",
+			v:        All(MinLen(3), Lowercase(), NoSpaces()),
+			accepted: []string{"abc", "my-cache"},
+			rejected: []string{"ab"},
+			wantErr:  "at least 3 characters",
+		},
+		{
+			name:     "All without validators",
+			v:        All(),
+			accepted: []string{"", "anything at all"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check(t, tt.v, tt.accepted, tt.rejected, tt.wantErr)
+		})
+	}
+}
+
+func TestAllReturnsTheFirstError(t *testing.T) {
+	v := All(MinLen(3), Lowercase())
+	if err := v("AB"); err == nil || !strings.Contains(err.Error(), "at least 3") {
+		t.Errorf("All(...)(%q) = %v, want MinLen's error first", "AB", err)
+	}
+	if err := v("ABC"); err == nil || !strings.Contains(err.Error(), "lowercase") {
+		t.Errorf("All(...)(%q) = %v, want Lowercase's error", "ABC", err)
+	}
+}
+
+func TestRegexPanicsOnBadPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Regex with an invalid pattern didn't panic")
+		}
+	}()
+	Regex(`[`, "anything")
+}